@@ -39,9 +39,10 @@ func TestAccPreCheck(t *testing.T) {
 		t.Fatal("ABRHA_TOKEN must be set for acceptance tests")
 	}
 
-	err := TestAccProvider.Configure(context.Background(), terraform.NewResourceConfigRaw(nil))
-	if err != nil {
-		t.Fatal(err)
+	// Configure always returns the billing warning, so only fail on errors.
+	diags := TestAccProvider.Configure(context.Background(), terraform.NewResourceConfigRaw(nil))
+	if diags.HasError() {
+		t.Fatal(diags)
 	}
 }
 
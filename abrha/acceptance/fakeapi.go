@@ -0,0 +1,1418 @@
+package acceptance
+
+import (
+	"encoding/json"
+	"errors"
+	"fmt"
+	"io"
+	"net/http"
+	"net/http/httptest"
+	"sort"
+	"strconv"
+	"strings"
+	"sync"
+	"testing"
+	"time"
+
+	"github.com/hashicorp/go-uuid"
+)
+
+const fakeAPIBasePath = "/api/public/v1/"
+
+// fakeSizes holds the size catalog returned by the fake API for Vms. Sizes
+// not listed here are echoed back with only their slug populated.
+var fakeSizes = map[string]map[string]interface{}{
+	"s-1vcpu-1gb": {
+		"slug":          "s-1vcpu-1gb",
+		"memory":        1024,
+		"vcpus":         1,
+		"disk":          25,
+		"price_monthly": 6.0,
+		"price_hourly":  0.00893,
+	},
+	"s-1vcpu-2gb": {
+		"slug":          "s-1vcpu-2gb",
+		"memory":        2048,
+		"vcpus":         1,
+		"disk":          50,
+		"price_monthly": 12.0,
+		"price_hourly":  0.01786,
+	},
+	"s-2vcpu-2gb": {
+		"slug":          "s-2vcpu-2gb",
+		"memory":        2048,
+		"vcpus":         2,
+		"disk":          60,
+		"price_monthly": 18.0,
+		"price_hourly":  0.02679,
+	},
+}
+
+// FakeAPI is an in-process fake of the Abrha REST API built on
+// net/http/httptest. It keeps VMs, volumes, reserved IPs, firewalls, VPCs,
+// load balancers, domains and records, tags and projects in memory so that
+// resource.TestCase suites can exercise the provider without a real account.
+//
+// Every action issued through the fake completes immediately, and objects
+// return a 404 once they have been deleted.
+type FakeAPI struct {
+	Server *httptest.Server
+
+	mu          sync.Mutex
+	seq         int
+	collections map[string]*fakeCollection
+	actions     map[int]map[string]interface{}
+}
+
+// fakeCollection is an ordered set of JSON objects sharing the same
+// response envelope, e.g. {"vm": {...}} and {"vms": [...]}.
+type fakeCollection struct {
+	single  string
+	plural  string
+	key     string
+	order   []string
+	objects map[string]map[string]interface{}
+}
+
+func newFakeCollection(single, plural, key string) *fakeCollection {
+	return &fakeCollection{
+		single:  single,
+		plural:  plural,
+		key:     key,
+		objects: map[string]map[string]interface{}{},
+	}
+}
+
+func (c *fakeCollection) put(obj map[string]interface{}) {
+	id := fmt.Sprint(obj[c.key])
+	if _, ok := c.objects[id]; !ok {
+		c.order = append(c.order, id)
+	}
+	c.objects[id] = obj
+}
+
+func (c *fakeCollection) get(id string) (map[string]interface{}, bool) {
+	obj, ok := c.objects[id]
+	return obj, ok
+}
+
+func (c *fakeCollection) remove(id string) bool {
+	if _, ok := c.objects[id]; !ok {
+		return false
+	}
+	delete(c.objects, id)
+	for i, v := range c.order {
+		if v == id {
+			c.order = append(c.order[:i], c.order[i+1:]...)
+			break
+		}
+	}
+	return true
+}
+
+func (c *fakeCollection) list(filter func(map[string]interface{}) bool) []interface{} {
+	objs := make([]interface{}, 0, len(c.order))
+	for _, id := range c.order {
+		obj := c.objects[id]
+		if filter == nil || filter(obj) {
+			objs = append(objs, obj)
+		}
+	}
+	return objs
+}
+
+// NewFakeAPI starts a new fake Abrha API server. Callers must Close it once
+// finished; tests should generally prefer UseFakeAPI.
+func NewFakeAPI() *FakeAPI {
+	f := &FakeAPI{
+		collections: map[string]*fakeCollection{
+			"vms":            newFakeCollection("vm", "vms", "id"),
+			"volumes":        newFakeCollection("volume", "volumes", "id"),
+			"reserved_ips":   newFakeCollection("reserved_ip", "reserved_ips", "ip"),
+			"firewalls":      newFakeCollection("firewall", "firewalls", "id"),
+			"vpcs":           newFakeCollection("vpc", "vpcs", "id"),
+			"load_balancers": newFakeCollection("load_balancer", "load_balancers", "id"),
+			"domains":        newFakeCollection("domain", "domains", "name"),
+			"records":        newFakeCollection("domain_record", "domain_records", "id"),
+			"tags":           newFakeCollection("tag", "tags", "name"),
+			"projects":       newFakeCollection("project", "projects", "id"),
+		},
+		actions: map[int]map[string]interface{}{},
+	}
+
+	f.collections["projects"].put(map[string]interface{}{
+		"id":          f.newUUID(),
+		"owner_uuid":  f.newUUID(),
+		"name":        "Default",
+		"description": "Default project",
+		"purpose":     "Just trying out Abrha",
+		"environment": "Development",
+		"is_default":  true,
+		"created_at":  fakeTimestamp(),
+		"updated_at":  fakeTimestamp(),
+		"resources":   []interface{}{},
+	})
+
+	f.Server = httptest.NewServer(http.HandlerFunc(f.serveHTTP))
+	return f
+}
+
+// UseFakeAPI starts a fake Abrha API server for the duration of the test and
+// points the provider at it through the ABRHA_API_URL and ABRHA_TOKEN
+// environment variables, so TestAccPreCheck and the provider factories talk to
+// the fake instead of the real cloud. As it relies on t.Setenv, it cannot be
+// used from parallel tests.
+func UseFakeAPI(t *testing.T) *FakeAPI {
+	t.Helper()
+
+	f := NewFakeAPI()
+	t.Cleanup(f.Close)
+
+	t.Setenv("ABRHA_TOKEN", "fake-api-token")
+	t.Setenv("ABRHA_API_URL", f.URL())
+
+	return f
+}
+
+// URL returns the base URL of the fake API, suitable for use as the
+// provider's api_endpoint.
+func (f *FakeAPI) URL() string {
+	return f.Server.URL + "/"
+}
+
+// Close shuts down the fake API server.
+func (f *FakeAPI) Close() {
+	f.Server.Close()
+}
+
+// Object returns a copy of the object with the given ID from a collection
+// of the fake API. The collection is named after its URL path segment, e.g.
+// "vms" or "load_balancers"; domain records are stored under "records".
+func (f *FakeAPI) Object(collection, id string) (map[string]interface{}, bool) {
+	f.mu.Lock()
+	defer f.mu.Unlock()
+
+	c, ok := f.collections[collection]
+	if !ok {
+		return nil, false
+	}
+	obj, ok := c.get(id)
+	if !ok {
+		return nil, false
+	}
+	return copyFakeObject(obj), true
+}
+
+// Put seeds an object into a collection of the fake API, replacing any
+// existing object with the same ID. It can be used to simulate
+// infrastructure created outside of Terraform.
+func (f *FakeAPI) Put(collection string, obj map[string]interface{}) {
+	f.mu.Lock()
+	defer f.mu.Unlock()
+
+	c, ok := f.collections[collection]
+	if !ok {
+		panic(fmt.Sprintf("fake API has no collection named %q", collection))
+	}
+	c.put(copyFakeObject(obj))
+}
+
+func (f *FakeAPI) serveHTTP(w http.ResponseWriter, r *http.Request) {
+	f.mu.Lock()
+	defer f.mu.Unlock()
+
+	if r.Header.Get("Authorization") == "" {
+		writeFakeError(w, http.StatusUnauthorized, "unauthorized", "Unable to authenticate you.")
+		return
+	}
+
+	if !strings.HasPrefix(r.URL.Path, fakeAPIBasePath) {
+		writeFakeNotFound(w, r)
+		return
+	}
+	parts := strings.Split(strings.Trim(strings.TrimPrefix(r.URL.Path, fakeAPIBasePath), "/"), "/")
+
+	var body map[string]interface{}
+	if r.Body != nil && r.Method != http.MethodGet {
+		if err := json.NewDecoder(r.Body).Decode(&body); err != nil && !errors.Is(err, io.EOF) {
+			writeFakeError(w, http.StatusBadRequest, "bad_request", err.Error())
+			return
+		}
+	}
+	if body == nil {
+		body = map[string]interface{}{}
+	}
+
+	switch parts[0] {
+	case "actions":
+		f.serveActions(w, r, parts[1:])
+	case "vms":
+		f.serveVms(w, r, parts[1:], body)
+	case "volumes":
+		f.serveVolumes(w, r, parts[1:], body)
+	case "reserved_ips":
+		f.serveReservedIPs(w, r, parts[1:], body)
+	case "firewalls":
+		f.serveFirewalls(w, r, parts[1:], body)
+	case "vpcs":
+		f.serveVPCs(w, r, parts[1:], body)
+	case "load_balancers":
+		f.serveLoadBalancers(w, r, parts[1:], body)
+	case "domains":
+		f.serveDomains(w, r, parts[1:], body)
+	case "tags":
+		f.serveTags(w, r, parts[1:], body)
+	case "projects":
+		f.serveProjects(w, r, parts[1:], body)
+	default:
+		writeFakeNotFound(w, r)
+	}
+}
+
+func (f *FakeAPI) serveActions(w http.ResponseWriter, r *http.Request, parts []string) {
+	if r.Method != http.MethodGet {
+		writeFakeNotFound(w, r)
+		return
+	}
+	if len(parts) == 0 {
+		actions := make([]interface{}, 0, len(f.actions))
+		ids := make([]int, 0, len(f.actions))
+		for id := range f.actions {
+			ids = append(ids, id)
+		}
+		sort.Ints(ids)
+		for _, id := range ids {
+			actions = append(actions, f.actions[id])
+		}
+		writeFakeList(w, "actions", actions)
+		return
+	}
+	f.writeAction(w, r, parts[0])
+}
+
+func (f *FakeAPI) serveVms(w http.ResponseWriter, r *http.Request, parts []string, body map[string]interface{}) {
+	vms := f.collections["vms"]
+
+	switch {
+	case len(parts) == 0 && r.Method == http.MethodGet:
+		tag := r.URL.Query().Get("tag_name")
+		name := r.URL.Query().Get("name")
+		writeFakeList(w, vms.plural, vms.list(func(vm map[string]interface{}) bool {
+			return (tag == "" || fakeContains(vm["tags"], tag)) && (name == "" || vm["name"] == name)
+		}))
+	case len(parts) == 0 && r.Method == http.MethodPost:
+		vm := f.newVm(body)
+		vms.put(vm)
+		action := f.newAction("create", vm["id"].(string), "vm", vm["region"])
+		writeFakeJSON(w, http.StatusAccepted, map[string]interface{}{
+			"vm": vm,
+			"links": map[string]interface{}{
+				"actions": []interface{}{
+					map[string]interface{}{
+						"id":   action["id"],
+						"rel":  "create",
+						"href": fmt.Sprintf("%sactions/%d", f.Server.URL+fakeAPIBasePath, action["id"]),
+					},
+				},
+			},
+		})
+	case len(parts) == 0 && r.Method == http.MethodDelete:
+		tag := r.URL.Query().Get("tag_name")
+		for _, vm := range vms.list(func(vm map[string]interface{}) bool { return fakeContains(vm["tags"], tag) }) {
+			f.deleteVm(vm.(map[string]interface{})["id"].(string))
+		}
+		w.WriteHeader(http.StatusNoContent)
+	case len(parts) == 1 && parts[0] == "actions" && r.Method == http.MethodPost:
+		tag := r.URL.Query().Get("tag_name")
+		actions := []interface{}{}
+		for _, vm := range vms.list(func(vm map[string]interface{}) bool { return fakeContains(vm["tags"], tag) }) {
+			actions = append(actions, f.applyVmAction(vm.(map[string]interface{}), body))
+		}
+		writeFakeList(w, "actions", actions)
+	case len(parts) == 1 && r.Method == http.MethodGet:
+		f.writeObject(w, r, vms, parts[0])
+	case len(parts) == 1 && r.Method == http.MethodDelete:
+		if !f.deleteVm(parts[0]) {
+			writeFakeNotFound(w, r)
+			return
+		}
+		w.WriteHeader(http.StatusNoContent)
+	case len(parts) == 2 && parts[1] == "actions" && r.Method == http.MethodPost:
+		vm, ok := vms.get(parts[0])
+		if !ok {
+			writeFakeNotFound(w, r)
+			return
+		}
+		writeFakeJSON(w, http.StatusCreated, map[string]interface{}{"action": f.applyVmAction(vm, body)})
+	case len(parts) == 2 && parts[1] == "actions" && r.Method == http.MethodGet:
+		writeFakeList(w, "actions", f.actionsFor(parts[0]))
+	case len(parts) == 3 && parts[1] == "actions" && r.Method == http.MethodGet:
+		f.writeAction(w, r, parts[2])
+	case len(parts) == 2 && (parts[1] == "backups" || parts[1] == "snapshots" || parts[1] == "kernels" || parts[1] == "neighbors"):
+		if _, ok := vms.get(parts[0]); !ok {
+			writeFakeNotFound(w, r)
+			return
+		}
+		writeFakeList(w, parts[1], []interface{}{})
+	default:
+		writeFakeNotFound(w, r)
+	}
+}
+
+func (f *FakeAPI) newVm(body map[string]interface{}) map[string]interface{} {
+	id := f.newUUID()
+	region := fakeString(body["region"])
+	sizeSlug := fakeString(body["size"])
+
+	size, ok := fakeSizes[sizeSlug]
+	if !ok {
+		size = map[string]interface{}{"slug": sizeSlug}
+	}
+	size = copyFakeObject(size)
+
+	image := map[string]interface{}{}
+	switch v := body["image"].(type) {
+	case string:
+		image["slug"] = v
+		image["name"] = v
+	case float64:
+		image["id"] = v
+	}
+
+	features := []interface{}{}
+	for _, feature := range []string{"backups", "ipv6", "private_networking", "monitoring"} {
+		if enabled, _ := body[feature].(bool); enabled {
+			features = append(features, feature)
+		}
+	}
+
+	vpcUUID := fakeString(body["vpc_uuid"])
+	if vpcUUID == "" {
+		vpcUUID = f.defaultVPC(region)
+	}
+
+	volumeIDs := []interface{}{}
+	if volumes, ok := body["volumes"].([]interface{}); ok {
+		for _, v := range volumes {
+			if volume, ok := v.(map[string]interface{}); ok {
+				volumeIDs = append(volumeIDs, volume["id"])
+			}
+		}
+	}
+
+	tags := []interface{}{}
+	if v, ok := body["tags"].([]interface{}); ok {
+		tags = v
+	}
+	for _, t := range tags {
+		f.ensureTag(fakeString(t))
+	}
+
+	networks := map[string]interface{}{
+		"v4": []interface{}{
+			map[string]interface{}{"ip_address": f.newIPv4("203.0.113"), "netmask": "255.255.240.0", "gateway": "203.0.113.1", "type": "public"},
+			map[string]interface{}{"ip_address": f.newIPv4("10.10.0"), "netmask": "255.255.0.0", "gateway": "10.10.0.1", "type": "private"},
+		},
+	}
+	if fakeContains(features, "ipv6") {
+		networks["v6"] = fakeIPv6Networks(f.seq)
+	}
+
+	vm := map[string]interface{}{
+		"id":         id,
+		"name":       body["name"],
+		"memory":     size["memory"],
+		"vcpus":      size["vcpus"],
+		"disk":       size["disk"],
+		"region":     map[string]interface{}{"slug": region, "name": region, "available": true},
+		"image":      image,
+		"size":       size,
+		"size_slug":  sizeSlug,
+		"features":   features,
+		"locked":     false,
+		"status":     "active",
+		"networks":   networks,
+		"created_at": fakeTimestamp(),
+		"tags":       tags,
+		"volume_ids": volumeIDs,
+		"vpc_uuid":   vpcUUID,
+	}
+	return vm
+}
+
+func (f *FakeAPI) deleteVm(id string) bool {
+	if !f.collections["vms"].remove(id) {
+		return false
+	}
+
+	for _, v := range f.collections["volumes"].list(nil) {
+		volume := v.(map[string]interface{})
+		volume["vm_ids"] = fakeWithout(volume["vm_ids"], id)
+	}
+	for _, v := range f.collections["reserved_ips"].list(nil) {
+		ip := v.(map[string]interface{})
+		if vm, ok := ip["vm"].(map[string]interface{}); ok && vm["id"] == id {
+			ip["vm"] = nil
+		}
+	}
+	return true
+}
+
+func (f *FakeAPI) applyVmAction(vm map[string]interface{}, body map[string]interface{}) map[string]interface{} {
+	actionType := fakeString(body["type"])
+
+	switch actionType {
+	case "power_off", "shutdown":
+		vm["status"] = "off"
+	case "power_on", "reboot", "power_cycle":
+		vm["status"] = "active"
+	case "rename":
+		vm["name"] = body["name"]
+	case "resize":
+		sizeSlug := fakeString(body["size"])
+		size, ok := fakeSizes[sizeSlug]
+		if !ok {
+			size = map[string]interface{}{"slug": sizeSlug}
+		}
+		size = copyFakeObject(size)
+		vm["size"] = size
+		vm["size_slug"] = sizeSlug
+		vm["memory"] = size["memory"]
+		vm["vcpus"] = size["vcpus"]
+		if resizeDisk, _ := body["disk"].(bool); resizeDisk {
+			vm["disk"] = size["disk"]
+		}
+	case "rebuild":
+		switch v := body["image"].(type) {
+		case string:
+			vm["image"] = map[string]interface{}{"slug": v, "name": v}
+		case float64:
+			vm["image"] = map[string]interface{}{"id": v}
+		}
+	case "enable_backups":
+		vm["features"] = fakeWith(vm["features"], "backups")
+	case "disable_backups":
+		vm["features"] = fakeWithout(vm["features"], "backups")
+	case "enable_ipv6":
+		vm["features"] = fakeWith(vm["features"], "ipv6")
+		if networks, ok := vm["networks"].(map[string]interface{}); ok {
+			networks["v6"] = fakeIPv6Networks(f.seq)
+		}
+	case "enable_private_networking":
+		vm["features"] = fakeWith(vm["features"], "private_networking")
+	}
+
+	return f.newAction(actionType, vm["id"].(string), "vm", vm["region"])
+}
+
+func (f *FakeAPI) serveVolumes(w http.ResponseWriter, r *http.Request, parts []string, body map[string]interface{}) {
+	volumes := f.collections["volumes"]
+
+	switch {
+	case len(parts) == 0 && r.Method == http.MethodGet:
+		name := r.URL.Query().Get("name")
+		region := r.URL.Query().Get("region")
+		writeFakeList(w, volumes.plural, volumes.list(func(volume map[string]interface{}) bool {
+			return (name == "" || volume["name"] == name) &&
+				(region == "" || fakeRegionSlug(volume["region"]) == region)
+		}))
+	case len(parts) == 0 && r.Method == http.MethodPost:
+		tags := []interface{}{}
+		if v, ok := body["tags"].([]interface{}); ok {
+			tags = v
+		}
+		for _, t := range tags {
+			f.ensureTag(fakeString(t))
+		}
+		volume := map[string]interface{}{
+			"id":               f.newUUID(),
+			"name":             body["name"],
+			"description":      body["description"],
+			"region":           map[string]interface{}{"slug": body["region"], "name": body["region"]},
+			"size_gigabytes":   body["size_gigabytes"],
+			"filesystem_type":  body["filesystem_type"],
+			"filesystem_label": body["filesystem_label"],
+			"vm_ids":           []interface{}{},
+			"tags":             tags,
+			"created_at":       fakeTimestamp(),
+		}
+		volumes.put(volume)
+		writeFakeJSON(w, http.StatusCreated, map[string]interface{}{volumes.single: volume})
+	case len(parts) == 1 && r.Method == http.MethodGet:
+		f.writeObject(w, r, volumes, parts[0])
+	case len(parts) == 1 && r.Method == http.MethodDelete:
+		volume, ok := volumes.get(parts[0])
+		if !ok {
+			writeFakeNotFound(w, r)
+			return
+		}
+		if ids, _ := volume["vm_ids"].([]interface{}); len(ids) > 0 {
+			writeFakeError(w, http.StatusConflict, "conflict", "volume is currently attached to a vm")
+			return
+		}
+		volumes.remove(parts[0])
+		w.WriteHeader(http.StatusNoContent)
+	case len(parts) == 2 && parts[1] == "actions" && r.Method == http.MethodPost:
+		volume, ok := volumes.get(parts[0])
+		if !ok {
+			writeFakeNotFound(w, r)
+			return
+		}
+		actionType := fakeString(body["type"])
+		vmID := fakeString(body["vm_id"])
+		switch actionType {
+		case "attach":
+			vm, ok := f.collections["vms"].get(vmID)
+			if !ok {
+				writeFakeNotFound(w, r)
+				return
+			}
+			volume["vm_ids"] = fakeWith(volume["vm_ids"], vmID)
+			vm["volume_ids"] = fakeWith(vm["volume_ids"], parts[0])
+		case "detach":
+			volume["vm_ids"] = fakeWithout(volume["vm_ids"], vmID)
+			if vm, ok := f.collections["vms"].get(vmID); ok {
+				vm["volume_ids"] = fakeWithout(vm["volume_ids"], parts[0])
+			}
+		case "resize":
+			volume["size_gigabytes"] = body["size_gigabytes"]
+		}
+		action := f.newAction(actionType, parts[0], "volume", volume["region"])
+		writeFakeJSON(w, http.StatusAccepted, map[string]interface{}{"action": action})
+	case len(parts) == 2 && parts[1] == "actions" && r.Method == http.MethodGet:
+		writeFakeList(w, "actions", f.actionsFor(parts[0]))
+	case len(parts) == 3 && parts[1] == "actions" && r.Method == http.MethodGet:
+		f.writeAction(w, r, parts[2])
+	default:
+		writeFakeNotFound(w, r)
+	}
+}
+
+func (f *FakeAPI) serveReservedIPs(w http.ResponseWriter, r *http.Request, parts []string, body map[string]interface{}) {
+	ips := f.collections["reserved_ips"]
+
+	switch {
+	case len(parts) == 0 && r.Method == http.MethodGet:
+		writeFakeList(w, ips.plural, ips.list(nil))
+	case len(parts) == 0 && r.Method == http.MethodPost:
+		ip := map[string]interface{}{
+			"ip":         f.newIPv4("192.0.2"),
+			"region":     map[string]interface{}{"slug": body["region"], "name": body["region"]},
+			"vm":         nil,
+			"project_id": body["project_id"],
+			"locked":     false,
+		}
+		if vmID := fakeString(body["vm_id"]); vmID != "" {
+			vm, ok := f.collections["vms"].get(vmID)
+			if !ok {
+				writeFakeError(w, http.StatusUnprocessableEntity, "unprocessable_entity", "vm not found")
+				return
+			}
+			ip["vm"] = copyFakeObject(vm)
+			ip["region"] = vm["region"]
+		}
+		ips.put(ip)
+		writeFakeJSON(w, http.StatusAccepted, map[string]interface{}{ips.single: ip})
+	case len(parts) == 1 && r.Method == http.MethodGet:
+		ip, ok := ips.get(parts[0])
+		if !ok {
+			writeFakeNotFound(w, r)
+			return
+		}
+		// Keep the embedded Vm in sync with the Vm collection.
+		if vm, ok := ip["vm"].(map[string]interface{}); ok {
+			if current, ok := f.collections["vms"].get(fakeString(vm["id"])); ok {
+				ip["vm"] = copyFakeObject(current)
+			}
+		}
+		writeFakeJSON(w, http.StatusOK, map[string]interface{}{ips.single: ip})
+	case len(parts) == 1 && r.Method == http.MethodDelete:
+		if !ips.remove(parts[0]) {
+			writeFakeNotFound(w, r)
+			return
+		}
+		w.WriteHeader(http.StatusNoContent)
+	case len(parts) == 2 && parts[1] == "actions" && r.Method == http.MethodPost:
+		ip, ok := ips.get(parts[0])
+		if !ok {
+			writeFakeNotFound(w, r)
+			return
+		}
+		actionType := fakeString(body["type"])
+		switch actionType {
+		case "assign":
+			vm, ok := f.collections["vms"].get(fakeString(body["vm_id"]))
+			if !ok {
+				writeFakeError(w, http.StatusUnprocessableEntity, "unprocessable_entity", "vm not found")
+				return
+			}
+			ip["vm"] = copyFakeObject(vm)
+		case "unassign":
+			ip["vm"] = nil
+		}
+		action := f.newAction(actionType, parts[0], "reserved_ip", ip["region"])
+		writeFakeJSON(w, http.StatusCreated, map[string]interface{}{"action": action})
+	case len(parts) == 2 && parts[1] == "actions" && r.Method == http.MethodGet:
+		writeFakeList(w, "actions", f.actionsFor(parts[0]))
+	case len(parts) == 3 && parts[1] == "actions" && r.Method == http.MethodGet:
+		f.writeAction(w, r, parts[2])
+	default:
+		writeFakeNotFound(w, r)
+	}
+}
+
+func (f *FakeAPI) serveFirewalls(w http.ResponseWriter, r *http.Request, parts []string, body map[string]interface{}) {
+	firewalls := f.collections["firewalls"]
+
+	switch {
+	case len(parts) == 0 && r.Method == http.MethodGet:
+		writeFakeList(w, firewalls.plural, firewalls.list(nil))
+	case len(parts) == 0 && r.Method == http.MethodPost:
+		firewall := map[string]interface{}{
+			"id":              f.newUUID(),
+			"status":          "succeeded",
+			"created_at":      fakeTimestamp(),
+			"pending_changes": []interface{}{},
+			"inbound_rules":   []interface{}{},
+			"outbound_rules":  []interface{}{},
+			"vm_ids":          []interface{}{},
+			"tags":            []interface{}{},
+		}
+		fakeMerge(firewall, body)
+		firewalls.put(firewall)
+		writeFakeJSON(w, http.StatusAccepted, map[string]interface{}{firewalls.single: firewall})
+	case len(parts) == 1 && r.Method == http.MethodGet:
+		f.writeObject(w, r, firewalls, parts[0])
+	case len(parts) == 1 && r.Method == http.MethodPut:
+		firewall, ok := firewalls.get(parts[0])
+		if !ok {
+			writeFakeNotFound(w, r)
+			return
+		}
+		for _, k := range []string{"inbound_rules", "outbound_rules", "vm_ids", "tags"} {
+			firewall[k] = []interface{}{}
+		}
+		fakeMerge(firewall, body)
+		writeFakeJSON(w, http.StatusOK, map[string]interface{}{firewalls.single: firewall})
+	case len(parts) == 1 && r.Method == http.MethodDelete:
+		f.deleteObject(w, r, firewalls, parts[0])
+	case len(parts) == 2 && (r.Method == http.MethodPost || r.Method == http.MethodDelete):
+		firewall, ok := firewalls.get(parts[0])
+		if !ok {
+			writeFakeNotFound(w, r)
+			return
+		}
+		fields := map[string][]string{
+			"vms":   {"vm_ids"},
+			"tags":  {"tags"},
+			"rules": {"inbound_rules", "outbound_rules"},
+		}[parts[1]]
+		if fields == nil {
+			writeFakeNotFound(w, r)
+			return
+		}
+		for _, field := range fields {
+			items, _ := body[field].([]interface{})
+			for _, item := range items {
+				if r.Method == http.MethodPost {
+					firewall[field] = fakeWith(firewall[field], item)
+				} else {
+					firewall[field] = fakeWithout(firewall[field], item)
+				}
+			}
+		}
+		w.WriteHeader(http.StatusNoContent)
+	default:
+		writeFakeNotFound(w, r)
+	}
+}
+
+func (f *FakeAPI) serveVPCs(w http.ResponseWriter, r *http.Request, parts []string, body map[string]interface{}) {
+	vpcs := f.collections["vpcs"]
+
+	switch {
+	case len(parts) == 0 && r.Method == http.MethodGet:
+		writeFakeList(w, vpcs.plural, vpcs.list(nil))
+	case len(parts) == 0 && r.Method == http.MethodPost:
+		id := f.newUUID()
+		vpc := map[string]interface{}{
+			"id":         id,
+			"urn":        "do:vpc:" + id,
+			"ip_range":   fmt.Sprintf("10.%d.0.0/20", 100+f.seq%100),
+			"created_at": fakeTimestamp(),
+			"default":    false,
+		}
+		fakeMerge(vpc, body)
+		vpcs.put(vpc)
+		writeFakeJSON(w, http.StatusCreated, map[string]interface{}{vpcs.single: vpc})
+	case len(parts) == 1 && r.Method == http.MethodGet:
+		f.writeObject(w, r, vpcs, parts[0])
+	case len(parts) == 1 && (r.Method == http.MethodPut || r.Method == http.MethodPatch):
+		f.updateObject(w, r, vpcs, parts[0], body)
+	case len(parts) == 1 && r.Method == http.MethodDelete:
+		vpc, ok := vpcs.get(parts[0])
+		if !ok {
+			writeFakeNotFound(w, r)
+			return
+		}
+		if len(f.vpcMembers(parts[0])) > 0 {
+			writeFakeError(w, http.StatusForbidden, "forbidden", "Can not delete VPC with members")
+			return
+		}
+		if isDefault, _ := vpc["default"].(bool); isDefault {
+			writeFakeError(w, http.StatusForbidden, "forbidden", "Can not delete default VPC")
+			return
+		}
+		vpcs.remove(parts[0])
+		w.WriteHeader(http.StatusNoContent)
+	case len(parts) == 2 && parts[1] == "members" && r.Method == http.MethodGet:
+		if _, ok := vpcs.get(parts[0]); !ok {
+			writeFakeNotFound(w, r)
+			return
+		}
+		writeFakeList(w, "members", f.vpcMembers(parts[0]))
+	default:
+		writeFakeNotFound(w, r)
+	}
+}
+
+func (f *FakeAPI) vpcMembers(id string) []interface{} {
+	members := []interface{}{}
+	for _, v := range f.collections["vms"].list(nil) {
+		vm := v.(map[string]interface{})
+		if vm["vpc_uuid"] == id {
+			members = append(members, map[string]interface{}{
+				"urn":        "do:vm:" + fakeString(vm["id"]),
+				"name":       vm["name"],
+				"created_at": vm["created_at"],
+			})
+		}
+	}
+	for _, v := range f.collections["load_balancers"].list(nil) {
+		lb := v.(map[string]interface{})
+		if lb["vpc_uuid"] == id {
+			members = append(members, map[string]interface{}{
+				"urn":        "do:loadbalancer:" + fakeString(lb["id"]),
+				"name":       lb["name"],
+				"created_at": lb["created_at"],
+			})
+		}
+	}
+	return members
+}
+
+// defaultVPC returns the ID of the default VPC for a region, creating it on
+// first use.
+func (f *FakeAPI) defaultVPC(region string) string {
+	vpcs := f.collections["vpcs"]
+	for _, v := range vpcs.list(nil) {
+		vpc := v.(map[string]interface{})
+		if isDefault, _ := vpc["default"].(bool); isDefault && vpc["region"] == region {
+			return vpc["id"].(string)
+		}
+	}
+
+	id := f.newUUID()
+	vpcs.put(map[string]interface{}{
+		"id":         id,
+		"urn":        "do:vpc:" + id,
+		"name":       "default-" + region,
+		"region":     region,
+		"ip_range":   "10.10.0.0/20",
+		"created_at": fakeTimestamp(),
+		"default":    true,
+	})
+	return id
+}
+
+func (f *FakeAPI) serveLoadBalancers(w http.ResponseWriter, r *http.Request, parts []string, body map[string]interface{}) {
+	lbs := f.collections["load_balancers"]
+
+	switch {
+	case len(parts) == 0 && r.Method == http.MethodGet:
+		writeFakeList(w, lbs.plural, lbs.list(nil))
+	case len(parts) == 0 && r.Method == http.MethodPost:
+		region := fakeString(body["region"])
+		lb := map[string]interface{}{
+			"id":         f.newUUID(),
+			"ip":         f.newIPv4("198.51.100"),
+			"status":     "active",
+			"created_at": fakeTimestamp(),
+		}
+		fakeMerge(lb, body)
+		lb["region"] = map[string]interface{}{"slug": region, "name": region}
+		if fakeString(lb["vpc_uuid"]) == "" && region != "" {
+			lb["vpc_uuid"] = f.defaultVPC(region)
+		}
+		lbs.put(lb)
+		writeFakeJSON(w, http.StatusAccepted, map[string]interface{}{lbs.single: lb})
+	case len(parts) == 1 && r.Method == http.MethodGet:
+		f.writeObject(w, r, lbs, parts[0])
+	case len(parts) == 1 && r.Method == http.MethodPut:
+		lb, ok := lbs.get(parts[0])
+		if !ok {
+			writeFakeNotFound(w, r)
+			return
+		}
+		region := lb["region"]
+		fakeMerge(lb, body)
+		lb["region"] = region
+		writeFakeJSON(w, http.StatusOK, map[string]interface{}{lbs.single: lb})
+	case len(parts) == 1 && r.Method == http.MethodDelete:
+		f.deleteObject(w, r, lbs, parts[0])
+	case len(parts) == 2 && (parts[1] == "vms" || parts[1] == "forwarding_rules") &&
+		(r.Method == http.MethodPost || r.Method == http.MethodDelete):
+		lb, ok := lbs.get(parts[0])
+		if !ok {
+			writeFakeNotFound(w, r)
+			return
+		}
+		field, key := "vm_ids", "vm_ids"
+		if parts[1] == "forwarding_rules" {
+			field, key = "forwarding_rules", "forwarding_rules"
+		}
+		items, _ := body[key].([]interface{})
+		for _, item := range items {
+			if r.Method == http.MethodPost {
+				lb[field] = fakeWith(lb[field], item)
+			} else {
+				lb[field] = fakeWithout(lb[field], item)
+			}
+		}
+		w.WriteHeader(http.StatusNoContent)
+	case len(parts) == 2 && parts[1] == "cache" && r.Method == http.MethodDelete:
+		if _, ok := lbs.get(parts[0]); !ok {
+			writeFakeNotFound(w, r)
+			return
+		}
+		w.WriteHeader(http.StatusNoContent)
+	default:
+		writeFakeNotFound(w, r)
+	}
+}
+
+func (f *FakeAPI) serveDomains(w http.ResponseWriter, r *http.Request, parts []string, body map[string]interface{}) {
+	domains := f.collections["domains"]
+	records := f.collections["records"]
+
+	switch {
+	case len(parts) == 0 && r.Method == http.MethodGet:
+		writeFakeList(w, domains.plural, domains.list(nil))
+	case len(parts) == 0 && r.Method == http.MethodPost:
+		name := fakeString(body["name"])
+		if _, ok := domains.get(name); ok {
+			writeFakeError(w, http.StatusUnprocessableEntity, "unprocessable_entity", "Name already exists")
+			return
+		}
+		domain := map[string]interface{}{
+			"name":      name,
+			"ttl":       1800,
+			"zone_file": "",
+		}
+		domains.put(domain)
+		writeFakeJSON(w, http.StatusCreated, map[string]interface{}{domains.single: domain})
+	case len(parts) == 1 && r.Method == http.MethodGet:
+		f.writeObject(w, r, domains, parts[0])
+	case len(parts) == 1 && r.Method == http.MethodDelete:
+		if !domains.remove(parts[0]) {
+			writeFakeNotFound(w, r)
+			return
+		}
+		for _, v := range records.list(func(record map[string]interface{}) bool { return record["domain"] == parts[0] }) {
+			records.remove(fmt.Sprint(v.(map[string]interface{})["id"]))
+		}
+		w.WriteHeader(http.StatusNoContent)
+	case len(parts) >= 2 && parts[1] == "records":
+		if _, ok := domains.get(parts[0]); !ok {
+			writeFakeNotFound(w, r)
+			return
+		}
+		f.serveRecords(w, r, parts[0], parts[2:], body)
+	default:
+		writeFakeNotFound(w, r)
+	}
+}
+
+func (f *FakeAPI) serveRecords(w http.ResponseWriter, r *http.Request, domain string, parts []string, body map[string]interface{}) {
+	records := f.collections["records"]
+
+	// Records are stored alongside the name of their domain, which is
+	// stripped before they are returned to the client.
+	strip := func(record map[string]interface{}) map[string]interface{} {
+		out := copyFakeObject(record)
+		delete(out, "domain")
+		return out
+	}
+
+	switch {
+	case len(parts) == 0 && r.Method == http.MethodGet:
+		recordType := r.URL.Query().Get("type")
+		name := r.URL.Query().Get("name")
+		list := []interface{}{}
+		for _, v := range records.list(func(record map[string]interface{}) bool {
+			return record["domain"] == domain &&
+				(recordType == "" || record["type"] == recordType) &&
+				(name == "" || record["name"] == name)
+		}) {
+			list = append(list, strip(v.(map[string]interface{})))
+		}
+		writeFakeList(w, records.plural, list)
+	case len(parts) == 0 && r.Method == http.MethodPost:
+		f.seq++
+		record := map[string]interface{}{
+			"id":       f.seq,
+			"priority": 0,
+			"port":     0,
+			"weight":   0,
+			"flags":    0,
+			"ttl":      1800,
+		}
+		fakeMerge(record, body)
+		record["domain"] = domain
+		records.put(record)
+		writeFakeJSON(w, http.StatusCreated, map[string]interface{}{records.single: strip(record)})
+	case len(parts) == 1:
+		record, ok := records.get(parts[0])
+		if !ok || record["domain"] != domain {
+			writeFakeNotFound(w, r)
+			return
+		}
+		switch r.Method {
+		case http.MethodGet:
+			writeFakeJSON(w, http.StatusOK, map[string]interface{}{records.single: strip(record)})
+		case http.MethodPut, http.MethodPatch:
+			fakeMerge(record, body)
+			writeFakeJSON(w, http.StatusOK, map[string]interface{}{records.single: strip(record)})
+		case http.MethodDelete:
+			records.remove(parts[0])
+			w.WriteHeader(http.StatusNoContent)
+		default:
+			writeFakeNotFound(w, r)
+		}
+	default:
+		writeFakeNotFound(w, r)
+	}
+}
+
+func (f *FakeAPI) serveTags(w http.ResponseWriter, r *http.Request, parts []string, body map[string]interface{}) {
+	tags := f.collections["tags"]
+
+	switch {
+	case len(parts) == 0 && r.Method == http.MethodGet:
+		list := []interface{}{}
+		for _, v := range tags.list(nil) {
+			list = append(list, f.tagWithResources(v.(map[string]interface{})))
+		}
+		writeFakeList(w, tags.plural, list)
+	case len(parts) == 0 && r.Method == http.MethodPost:
+		tag := f.ensureTag(fakeString(body["name"]))
+		writeFakeJSON(w, http.StatusCreated, map[string]interface{}{tags.single: f.tagWithResources(tag)})
+	case len(parts) == 1 && r.Method == http.MethodGet:
+		tag, ok := tags.get(parts[0])
+		if !ok {
+			writeFakeNotFound(w, r)
+			return
+		}
+		writeFakeJSON(w, http.StatusOK, map[string]interface{}{tags.single: f.tagWithResources(tag)})
+	case len(parts) == 1 && r.Method == http.MethodDelete:
+		if !tags.remove(parts[0]) {
+			writeFakeNotFound(w, r)
+			return
+		}
+		for _, name := range []string{"vms", "volumes"} {
+			for _, v := range f.collections[name].list(nil) {
+				obj := v.(map[string]interface{})
+				obj["tags"] = fakeWithout(obj["tags"], parts[0])
+			}
+		}
+		w.WriteHeader(http.StatusNoContent)
+	case len(parts) == 2 && parts[1] == "resources" && (r.Method == http.MethodPost || r.Method == http.MethodDelete):
+		if _, ok := tags.get(parts[0]); !ok {
+			writeFakeNotFound(w, r)
+			return
+		}
+		resources, _ := body["resources"].([]interface{})
+		for _, v := range resources {
+			resource, _ := v.(map[string]interface{})
+			collection := map[string]string{"vm": "vms", "volume": "volumes"}[fakeString(resource["resource_type"])]
+			if collection == "" {
+				continue
+			}
+			obj, ok := f.collections[collection].get(fakeString(resource["resource_id"]))
+			if !ok {
+				continue
+			}
+			if r.Method == http.MethodPost {
+				obj["tags"] = fakeWith(obj["tags"], parts[0])
+			} else {
+				obj["tags"] = fakeWithout(obj["tags"], parts[0])
+			}
+		}
+		w.WriteHeader(http.StatusNoContent)
+	default:
+		writeFakeNotFound(w, r)
+	}
+}
+
+func (f *FakeAPI) ensureTag(name string) map[string]interface{} {
+	tags := f.collections["tags"]
+	if tag, ok := tags.get(name); ok {
+		return tag
+	}
+	tag := map[string]interface{}{"name": name}
+	tags.put(tag)
+	return tag
+}
+
+// tagWithResources returns the tag along with the counts of the resources it
+// is currently attached to.
+func (f *FakeAPI) tagWithResources(tag map[string]interface{}) map[string]interface{} {
+	name := fakeString(tag["name"])
+	count := func(collection string) int {
+		return len(f.collections[collection].list(func(obj map[string]interface{}) bool {
+			return fakeContains(obj["tags"], name)
+		}))
+	}
+	vms, volumes := count("vms"), count("volumes")
+
+	return map[string]interface{}{
+		"name": name,
+		"resources": map[string]interface{}{
+			"count":            vms + volumes,
+			"vms":              map[string]interface{}{"count": vms},
+			"images":           map[string]interface{}{"count": 0},
+			"volumes":          map[string]interface{}{"count": volumes},
+			"volume_snapshots": map[string]interface{}{"count": 0},
+			"databases":        map[string]interface{}{"count": 0},
+		},
+	}
+}
+
+func (f *FakeAPI) serveProjects(w http.ResponseWriter, r *http.Request, parts []string, body map[string]interface{}) {
+	projects := f.collections["projects"]
+
+	// The "resources" attribute is internal bookkeeping and is served
+	// through its own endpoint.
+	strip := func(project map[string]interface{}) map[string]interface{} {
+		out := copyFakeObject(project)
+		delete(out, "resources")
+		return out
+	}
+
+	if len(parts) > 0 && parts[0] == "default" {
+		for _, v := range projects.list(nil) {
+			project := v.(map[string]interface{})
+			if isDefault, _ := project["is_default"].(bool); isDefault {
+				parts[0] = project["id"].(string)
+				break
+			}
+		}
+	}
+
+	switch {
+	case len(parts) == 0 && r.Method == http.MethodGet:
+		list := []interface{}{}
+		for _, v := range projects.list(nil) {
+			list = append(list, strip(v.(map[string]interface{})))
+		}
+		writeFakeList(w, projects.plural, list)
+	case len(parts) == 0 && r.Method == http.MethodPost:
+		project := map[string]interface{}{
+			"id":          f.newUUID(),
+			"owner_uuid":  f.newUUID(),
+			"description": "",
+			"purpose":     "",
+			"environment": "",
+			"is_default":  false,
+			"created_at":  fakeTimestamp(),
+			"updated_at":  fakeTimestamp(),
+		}
+		fakeMerge(project, body)
+		project["resources"] = []interface{}{}
+		projects.put(project)
+		writeFakeJSON(w, http.StatusCreated, map[string]interface{}{projects.single: strip(project)})
+	case len(parts) == 1 && r.Method == http.MethodGet:
+		project, ok := projects.get(parts[0])
+		if !ok {
+			writeFakeNotFound(w, r)
+			return
+		}
+		writeFakeJSON(w, http.StatusOK, map[string]interface{}{projects.single: strip(project)})
+	case len(parts) == 1 && (r.Method == http.MethodPut || r.Method == http.MethodPatch):
+		project, ok := projects.get(parts[0])
+		if !ok {
+			writeFakeNotFound(w, r)
+			return
+		}
+		for k, v := range body {
+			if v != nil {
+				project[k] = v
+			}
+		}
+		if isDefault, _ := body["is_default"].(bool); isDefault {
+			for _, v := range projects.list(nil) {
+				if other := v.(map[string]interface{}); other["id"] != project["id"] {
+					other["is_default"] = false
+				}
+			}
+		}
+		project["updated_at"] = fakeTimestamp()
+		writeFakeJSON(w, http.StatusOK, map[string]interface{}{projects.single: strip(project)})
+	case len(parts) == 1 && r.Method == http.MethodDelete:
+		project, ok := projects.get(parts[0])
+		if !ok {
+			writeFakeNotFound(w, r)
+			return
+		}
+		if resources, _ := project["resources"].([]interface{}); len(resources) > 0 {
+			writeFakeError(w, http.StatusPreconditionFailed, "precondition_failed", "cannot delete a project with resources")
+			return
+		}
+		projects.remove(parts[0])
+		w.WriteHeader(http.StatusNoContent)
+	case len(parts) == 2 && parts[1] == "resources":
+		project, ok := projects.get(parts[0])
+		if !ok {
+			writeFakeNotFound(w, r)
+			return
+		}
+		switch r.Method {
+		case http.MethodGet:
+			writeFakeList(w, "resources", f.projectResources(project))
+		case http.MethodPost:
+			urns, _ := body["resources"].([]interface{})
+			assigned := []interface{}{}
+			for _, urn := range urns {
+				// A resource can only belong to a single project.
+				for _, v := range projects.list(nil) {
+					other := v.(map[string]interface{})
+					other["resources"] = fakeWithout(other["resources"], urn)
+				}
+				project["resources"] = fakeWith(project["resources"], urn)
+				assigned = append(assigned, fakeProjectResource(fakeString(urn)))
+			}
+			writeFakeList(w, "resources", assigned)
+		default:
+			writeFakeNotFound(w, r)
+		}
+	default:
+		writeFakeNotFound(w, r)
+	}
+}
+
+func (f *FakeAPI) projectResources(project map[string]interface{}) []interface{} {
+	urns, _ := project["resources"].([]interface{})
+	resources := make([]interface{}, 0, len(urns))
+	for _, urn := range urns {
+		resources = append(resources, fakeProjectResource(fakeString(urn)))
+	}
+	return resources
+}
+
+func fakeProjectResource(urn string) map[string]interface{} {
+	return map[string]interface{}{
+		"urn":         urn,
+		"assigned_at": fakeTimestamp(),
+		"status":      "ok",
+		"links":       map[string]interface{}{"self": ""},
+	}
+}
+
+func (f *FakeAPI) newAction(actionType, resourceID, resourceType string, region interface{}) map[string]interface{} {
+	f.seq++
+	now := fakeTimestamp()
+	action := map[string]interface{}{
+		"id":            f.seq,
+		"status":        "completed",
+		"type":          actionType,
+		"started_at":    now,
+		"completed_at":  now,
+		"resource_id":   resourceID,
+		"resource_type": resourceType,
+		"region":        region,
+		"region_slug":   fakeRegionSlug(region),
+	}
+	f.actions[f.seq] = action
+	return action
+}
+
+func (f *FakeAPI) actionsFor(resourceID string) []interface{} {
+	ids := []int{}
+	for id, action := range f.actions {
+		if action["resource_id"] == resourceID {
+			ids = append(ids, id)
+		}
+	}
+	sort.Ints(ids)
+
+	actions := make([]interface{}, 0, len(ids))
+	for _, id := range ids {
+		actions = append(actions, f.actions[id])
+	}
+	return actions
+}
+
+func (f *FakeAPI) writeAction(w http.ResponseWriter, r *http.Request, rawID string) {
+	id, err := strconv.Atoi(rawID)
+	if err != nil {
+		writeFakeNotFound(w, r)
+		return
+	}
+	action, ok := f.actions[id]
+	if !ok {
+		writeFakeNotFound(w, r)
+		return
+	}
+	writeFakeJSON(w, http.StatusOK, map[string]interface{}{"action": action})
+}
+
+func (f *FakeAPI) writeObject(w http.ResponseWriter, r *http.Request, c *fakeCollection, id string) {
+	obj, ok := c.get(id)
+	if !ok {
+		writeFakeNotFound(w, r)
+		return
+	}
+	writeFakeJSON(w, http.StatusOK, map[string]interface{}{c.single: obj})
+}
+
+func (f *FakeAPI) updateObject(w http.ResponseWriter, r *http.Request, c *fakeCollection, id string, body map[string]interface{}) {
+	obj, ok := c.get(id)
+	if !ok {
+		writeFakeNotFound(w, r)
+		return
+	}
+	fakeMerge(obj, body)
+	writeFakeJSON(w, http.StatusOK, map[string]interface{}{c.single: obj})
+}
+
+func (f *FakeAPI) deleteObject(w http.ResponseWriter, r *http.Request, c *fakeCollection, id string) {
+	if !c.remove(id) {
+		writeFakeNotFound(w, r)
+		return
+	}
+	w.WriteHeader(http.StatusNoContent)
+}
+
+func (f *FakeAPI) newUUID() string {
+	id, err := uuid.GenerateUUID()
+	if err != nil {
+		panic(err)
+	}
+	return id
+}
+
+func (f *FakeAPI) newIPv4(prefix string) string {
+	f.seq++
+	return fmt.Sprintf("%s.%d", prefix, 2+f.seq%250)
+}
+
+func fakeIPv6Networks(seq int) []interface{} {
+	return []interface{}{
+		map[string]interface{}{
+			"ip_address": fmt.Sprintf("2001:db8::%x", seq),
+			"netmask":    64,
+			"gateway":    "2001:db8::1",
+			"type":       "public",
+		},
+	}
+}
+
+func fakeTimestamp() string {
+	return time.Now().UTC().Format(time.RFC3339)
+}
+
+func fakeString(v interface{}) string {
+	if s, ok := v.(string); ok {
+		return s
+	}
+	return ""
+}
+
+func fakeRegionSlug(region interface{}) string {
+	if r, ok := region.(map[string]interface{}); ok {
+		return fakeString(r["slug"])
+	}
+	return fakeString(region)
+}
+
+// fakeMerge copies the non-nil values of src into dst.
+func fakeMerge(dst, src map[string]interface{}) {
+	for k, v := range src {
+		if v != nil {
+			dst[k] = v
+		}
+	}
+}
+
+func fakeContains(list interface{}, item interface{}) bool {
+	items, _ := list.([]interface{})
+	for _, v := range items {
+		if fakeEqual(v, item) {
+			return true
+		}
+	}
+	return false
+}
+
+func fakeWith(list interface{}, item interface{}) []interface{} {
+	items, _ := list.([]interface{})
+	if fakeContains(items, item) {
+		return items
+	}
+	return append(items, item)
+}
+
+func fakeWithout(list interface{}, item interface{}) []interface{} {
+	items, _ := list.([]interface{})
+	out := make([]interface{}, 0, len(items))
+	for _, v := range items {
+		if !fakeEqual(v, item) {
+			out = append(out, v)
+		}
+	}
+	return out
+}
+
+func fakeEqual(a, b interface{}) bool {
+	ja, errA := json.Marshal(a)
+	jb, errB := json.Marshal(b)
+	return errA == nil && errB == nil && string(ja) == string(jb)
+}
+
+func copyFakeObject(obj map[string]interface{}) map[string]interface{} {
+	raw, err := json.Marshal(obj)
+	if err != nil {
+		panic(err)
+	}
+	var out map[string]interface{}
+	if err := json.Unmarshal(raw, &out); err != nil {
+		panic(err)
+	}
+	return out
+}
+
+func writeFakeList(w http.ResponseWriter, key string, items []interface{}) {
+	writeFakeJSON(w, http.StatusOK, map[string]interface{}{
+		key:     items,
+		"links": map[string]interface{}{},
+		"meta":  map[string]interface{}{"total": len(items)},
+	})
+}
+
+func writeFakeJSON(w http.ResponseWriter, status int, v interface{}) {
+	w.Header().Set("Content-Type", "application/json")
+	w.WriteHeader(status)
+	_ = json.NewEncoder(w).Encode(v)
+}
+
+func writeFakeError(w http.ResponseWriter, status int, id, message string) {
+	writeFakeJSON(w, status, map[string]interface{}{
+		"id":      id,
+		"message": message,
+	})
+}
+
+func writeFakeNotFound(w http.ResponseWriter, r *http.Request) {
+	writeFakeError(w, http.StatusNotFound, "not_found",
+		fmt.Sprintf("The resource you were accessing could not be found (%s %s).", r.Method, r.URL.Path))
+}
@@ -0,0 +1,632 @@
+package acceptance_test
+
+import (
+	"context"
+	"testing"
+
+	goApiAbrha "github.com/abrhacom/go-api-abrha"
+	"github.com/abrhacom/terraform-provider-abrha/abrha/acceptance"
+	"github.com/abrhacom/terraform-provider-abrha/abrha/config"
+)
+
+func newFakeAPIClient(t *testing.T, f *acceptance.FakeAPI) *goApiAbrha.Client {
+	conf := config.Config{
+		Token:             "fake-api-token",
+		APIEndpoint:       f.URL(),
+		SpacesAPIEndpoint: "https://{{.Region}}.example.com",
+	}
+	combined, err := conf.Client()
+	if err != nil {
+		t.Fatalf("error configuring client: %s", err)
+	}
+	return combined.GoApiAbrhaClient()
+}
+
+func TestFakeAPI_VmLifecycle(t *testing.T) {
+	f := acceptance.NewFakeAPI()
+	defer f.Close()
+	client := newFakeAPIClient(t, f)
+	ctx := context.Background()
+
+	root, _, err := client.Vms.Create(ctx, &goApiAbrha.VmCreateRequest{
+		Name:   "foobar",
+		Region: "nyc3",
+		Size:   "s-1vcpu-1gb",
+		Image:  goApiAbrha.VmCreateImage{Slug: "ubuntu-22-04-x64"},
+		Tags:   []string{"web"},
+	})
+	if err != nil {
+		t.Fatalf("error creating vm: %s", err)
+	}
+	if root.Links == nil || len(root.Links.Actions) != 1 {
+		t.Fatalf("expected a create action link, got %#v", root.Links)
+	}
+
+	action, _, err := client.VmActions.Get(ctx, root.Vm.ID, root.Links.Actions[0].ID)
+	if err != nil {
+		t.Fatalf("error getting create action: %s", err)
+	}
+	if action.Status != goApiAbrha.ActionCompleted || action.CompletedAt == nil {
+		t.Fatalf("expected completed action, got %#v", action)
+	}
+
+	vm, _, err := client.Vms.Get(ctx, root.Vm.ID)
+	if err != nil {
+		t.Fatalf("error getting vm: %s", err)
+	}
+	if vm.Size.Slug != "s-1vcpu-1gb" || vm.Size.PriceMonthly != 6 {
+		t.Errorf("unexpected size: %#v", vm.Size)
+	}
+	if vm.Region.Slug != "nyc3" || vm.VPCUUID == "" {
+		t.Errorf("unexpected region or VPC: %#v, %q", vm.Region, vm.VPCUUID)
+	}
+	if ip, _ := vm.PrivateIPv4(); ip == "" {
+		t.Errorf("expected a private IPv4 address")
+	}
+
+	if _, _, err := client.VmActions.PowerOff(ctx, vm.ID); err != nil {
+		t.Fatalf("error powering off vm: %s", err)
+	}
+	vm, _, err = client.Vms.Get(ctx, vm.ID)
+	if err != nil {
+		t.Fatalf("error getting vm: %s", err)
+	}
+	if vm.Status != "off" {
+		t.Errorf("expected status off, got %q", vm.Status)
+	}
+
+	tag, _, err := client.Tags.Get(ctx, "web")
+	if err != nil {
+		t.Fatalf("error getting tag: %s", err)
+	}
+	if tag.Resources.Vms.Count != 1 {
+		t.Errorf("expected tag to have 1 vm, got %d", tag.Resources.Vms.Count)
+	}
+
+	if _, err := client.Vms.Delete(ctx, vm.ID); err != nil {
+		t.Fatalf("error deleting vm: %s", err)
+	}
+	_, resp, err := client.Vms.Get(ctx, vm.ID)
+	if err == nil || resp == nil || resp.StatusCode != 404 {
+		t.Fatalf("expected 404 after delete, got %v", err)
+	}
+}
+
+func TestFakeAPI_VolumeAttachment(t *testing.T) {
+	f := acceptance.NewFakeAPI()
+	defer f.Close()
+	client := newFakeAPIClient(t, f)
+	ctx := context.Background()
+
+	root, _, err := client.Vms.Create(ctx, &goApiAbrha.VmCreateRequest{
+		Name:   "foobar",
+		Region: "nyc3",
+		Size:   "s-1vcpu-1gb",
+		Image:  goApiAbrha.VmCreateImage{Slug: "ubuntu-22-04-x64"},
+	})
+	if err != nil {
+		t.Fatalf("error creating vm: %s", err)
+	}
+
+	volume, _, err := client.Storage.CreateVolume(ctx, &goApiAbrha.VolumeCreateRequest{
+		Name:          "foobar",
+		Region:        "nyc3",
+		SizeGigaBytes: 10,
+	})
+	if err != nil {
+		t.Fatalf("error creating volume: %s", err)
+	}
+
+	if _, _, err := client.StorageActions.Attach(ctx, volume.ID, root.Vm.ID); err != nil {
+		t.Fatalf("error attaching volume: %s", err)
+	}
+	if _, err := client.Storage.DeleteVolume(ctx, volume.ID); err == nil {
+		t.Fatalf("expected an error deleting an attached volume")
+	}
+
+	vm, _, err := client.Vms.Get(ctx, root.Vm.ID)
+	if err != nil {
+		t.Fatalf("error getting vm: %s", err)
+	}
+	if len(vm.VolumeIDs) != 1 || vm.VolumeIDs[0] != volume.ID {
+		t.Errorf("expected vm to have volume %s, got %v", volume.ID, vm.VolumeIDs)
+	}
+
+	if _, _, err := client.StorageActions.DetachByVmID(ctx, volume.ID, root.Vm.ID); err != nil {
+		t.Fatalf("error detaching volume: %s", err)
+	}
+	if _, err := client.Storage.DeleteVolume(ctx, volume.ID); err != nil {
+		t.Fatalf("error deleting volume: %s", err)
+	}
+}
+
+func TestFakeAPI_DomainRecords(t *testing.T) {
+	f := acceptance.NewFakeAPI()
+	defer f.Close()
+	client := newFakeAPIClient(t, f)
+	ctx := context.Background()
+
+	if _, _, err := client.Domains.Create(ctx, &goApiAbrha.DomainCreateRequest{Name: "example.com"}); err != nil {
+		t.Fatalf("error creating domain: %s", err)
+	}
+
+	record, _, err := client.Domains.CreateRecord(ctx, "example.com", &goApiAbrha.DomainRecordEditRequest{
+		Type: "A",
+		Name: "www",
+		Data: "192.0.2.10",
+		TTL:  300,
+	})
+	if err != nil {
+		t.Fatalf("error creating record: %s", err)
+	}
+
+	record, _, err = client.Domains.EditRecord(ctx, "example.com", record.ID, &goApiAbrha.DomainRecordEditRequest{
+		Data: "192.0.2.20",
+	})
+	if err != nil {
+		t.Fatalf("error editing record: %s", err)
+	}
+	if record.Data != "192.0.2.20" || record.Name != "www" || record.TTL != 300 {
+		t.Errorf("unexpected record after edit: %#v", record)
+	}
+
+	if _, err := client.Domains.Delete(ctx, "example.com"); err != nil {
+		t.Fatalf("error deleting domain: %s", err)
+	}
+	if _, _, err := client.Domains.Record(ctx, "example.com", record.ID); err == nil {
+		t.Fatalf("expected record to be gone with its domain")
+	}
+}
+
+func TestFakeAPI_Projects(t *testing.T) {
+	f := acceptance.NewFakeAPI()
+	defer f.Close()
+	client := newFakeAPIClient(t, f)
+	ctx := context.Background()
+
+	defaultProject, _, err := client.Projects.GetDefault(ctx)
+	if err != nil {
+		t.Fatalf("error getting default project: %s", err)
+	}
+	if !defaultProject.IsDefault {
+		t.Fatalf("expected default project, got %#v", defaultProject)
+	}
+
+	project, _, err := client.Projects.Create(ctx, &goApiAbrha.CreateProjectRequest{
+		Name:    "foobar",
+		Purpose: "Web Application",
+	})
+	if err != nil {
+		t.Fatalf("error creating project: %s", err)
+	}
+
+	if _, _, err := client.Projects.AssignResources(ctx, project.ID, "do:vm:1234"); err != nil {
+		t.Fatalf("error assigning resources: %s", err)
+	}
+	if _, err := client.Projects.Delete(ctx, project.ID); err == nil {
+		t.Fatalf("expected an error deleting a project with resources")
+	}
+
+	if _, _, err := client.Projects.AssignResources(ctx, defaultProject.ID, "do:vm:1234"); err != nil {
+		t.Fatalf("error assigning resources: %s", err)
+	}
+	resources, _, err := client.Projects.ListResources(ctx, project.ID, nil)
+	if err != nil {
+		t.Fatalf("error listing resources: %s", err)
+	}
+	if len(resources) != 0 {
+		t.Fatalf("expected resources to move to the default project, got %v", resources)
+	}
+	if _, err := client.Projects.Delete(ctx, project.ID); err != nil {
+		t.Fatalf("error deleting project: %s", err)
+	}
+}
+
+func TestFakeAPI_Put(t *testing.T) {
+	f := acceptance.NewFakeAPI()
+	defer f.Close()
+	client := newFakeAPIClient(t, f)
+
+	f.Put("firewalls", map[string]interface{}{
+		"id":     "fw-1",
+		"name":   "seeded",
+		"status": "succeeded",
+	})
+
+	firewall, _, err := client.Firewalls.Get(context.Background(), "fw-1")
+	if err != nil {
+		t.Fatalf("error getting firewall: %s", err)
+	}
+	if firewall.Name != "seeded" {
+		t.Errorf("expected seeded firewall, got %#v", firewall)
+	}
+
+	if _, ok := f.Object("firewalls", "fw-1"); !ok {
+		t.Errorf("expected Object to return the seeded firewall")
+	}
+}
+
+func createFakeVm(t *testing.T, client *goApiAbrha.Client, name string) *goApiAbrha.Vm {
+	t.Helper()
+
+	root, _, err := client.Vms.Create(context.Background(), &goApiAbrha.VmCreateRequest{
+		Name:   name,
+		Region: "nyc3",
+		Size:   "s-1vcpu-1gb",
+		Image:  goApiAbrha.VmCreateImage{Slug: "ubuntu-22-04-x64"},
+	})
+	if err != nil {
+		t.Fatalf("error creating vm: %s", err)
+	}
+	return root.Vm
+}
+
+func TestFakeAPI_LoadBalancers(t *testing.T) {
+	f := acceptance.NewFakeAPI()
+	defer f.Close()
+	client := newFakeAPIClient(t, f)
+	ctx := context.Background()
+
+	vm := createFakeVm(t, client, "foobar")
+
+	lb, _, err := client.LoadBalancers.Create(ctx, &goApiAbrha.LoadBalancerRequest{
+		Name:   "foobar",
+		Region: "nyc3",
+		ForwardingRules: []goApiAbrha.ForwardingRule{
+			{EntryProtocol: "http", EntryPort: 80, TargetProtocol: "http", TargetPort: 80},
+		},
+	})
+	if err != nil {
+		t.Fatalf("error creating load balancer: %s", err)
+	}
+	if lb.IP == "" || lb.Status != "active" {
+		t.Errorf("expected an active load balancer with an IP, got %#v", lb)
+	}
+	if lb.Region == nil || lb.Region.Slug != "nyc3" {
+		t.Errorf("unexpected region: %#v", lb.Region)
+	}
+	if lb.VPCUUID == "" || lb.VPCUUID != vm.VPCUUID {
+		t.Errorf("expected the default VPC %q of the region, got %q", vm.VPCUUID, lb.VPCUUID)
+	}
+
+	if _, err := client.LoadBalancers.AddVms(ctx, lb.ID, vm.ID); err != nil {
+		t.Fatalf("error adding vms: %s", err)
+	}
+	if _, err := client.LoadBalancers.AddForwardingRules(ctx, lb.ID, goApiAbrha.ForwardingRule{
+		EntryProtocol: "tcp", EntryPort: 443, TargetProtocol: "tcp", TargetPort: 443,
+	}); err != nil {
+		t.Fatalf("error adding forwarding rules: %s", err)
+	}
+
+	lb, _, err = client.LoadBalancers.Get(ctx, lb.ID)
+	if err != nil {
+		t.Fatalf("error getting load balancer: %s", err)
+	}
+	if len(lb.VmIDs) != 1 || len(lb.ForwardingRules) != 2 {
+		t.Fatalf("expected 1 vm and 2 forwarding rules, got %v and %v", lb.VmIDs, lb.ForwardingRules)
+	}
+
+	if _, err := client.LoadBalancers.RemoveForwardingRules(ctx, lb.ID, lb.ForwardingRules[1]); err != nil {
+		t.Fatalf("error removing forwarding rules: %s", err)
+	}
+	if _, err := client.LoadBalancers.RemoveVms(ctx, lb.ID, lb.VmIDs...); err != nil {
+		t.Fatalf("error removing vms: %s", err)
+	}
+
+	lb, _, err = client.LoadBalancers.Update(ctx, lb.ID, &goApiAbrha.LoadBalancerRequest{
+		Name:   "renamed",
+		Region: "sfo3",
+	})
+	if err != nil {
+		t.Fatalf("error updating load balancer: %s", err)
+	}
+	if lb.Name != "renamed" || lb.Region.Slug != "nyc3" {
+		t.Errorf("expected the name to change and the region to stay, got %q in %#v", lb.Name, lb.Region)
+	}
+	if len(lb.VmIDs) != 0 || len(lb.ForwardingRules) != 1 {
+		t.Errorf("expected no vms and 1 forwarding rule, got %v and %v", lb.VmIDs, lb.ForwardingRules)
+	}
+
+	if _, err := client.LoadBalancers.PurgeCache(ctx, lb.ID); err != nil {
+		t.Fatalf("error purging cache: %s", err)
+	}
+
+	if _, err := client.LoadBalancers.Delete(ctx, lb.ID); err != nil {
+		t.Fatalf("error deleting load balancer: %s", err)
+	}
+	_, resp, err := client.LoadBalancers.Get(ctx, lb.ID)
+	if err == nil || resp == nil || resp.StatusCode != 404 {
+		t.Fatalf("expected 404 after delete, got %v", err)
+	}
+	if _, err := client.LoadBalancers.PurgeCache(ctx, lb.ID); err == nil {
+		t.Fatalf("expected an error purging the cache of a deleted load balancer")
+	}
+}
+
+func TestFakeAPI_Firewalls(t *testing.T) {
+	f := acceptance.NewFakeAPI()
+	defer f.Close()
+	client := newFakeAPIClient(t, f)
+	ctx := context.Background()
+
+	firewall, _, err := client.Firewalls.Create(ctx, &goApiAbrha.FirewallRequest{
+		Name: "foobar",
+		InboundRules: []goApiAbrha.InboundRule{
+			{Protocol: "tcp", PortRange: "22", Sources: &goApiAbrha.Sources{Addresses: []string{"0.0.0.0/0"}}},
+		},
+	})
+	if err != nil {
+		t.Fatalf("error creating firewall: %s", err)
+	}
+	if firewall.Status != "succeeded" || len(firewall.InboundRules) != 1 {
+		t.Fatalf("unexpected firewall: %#v", firewall)
+	}
+
+	if _, err := client.Firewalls.AddVms(ctx, firewall.ID, "1234"); err != nil {
+		t.Fatalf("error adding vms: %s", err)
+	}
+	if _, err := client.Firewalls.AddTags(ctx, firewall.ID, "web"); err != nil {
+		t.Fatalf("error adding tags: %s", err)
+	}
+	if _, err := client.Firewalls.AddRules(ctx, firewall.ID, &goApiAbrha.FirewallRulesRequest{
+		InboundRules: []goApiAbrha.InboundRule{
+			{Protocol: "tcp", PortRange: "443", Sources: &goApiAbrha.Sources{Addresses: []string{"0.0.0.0/0"}}},
+		},
+	}); err != nil {
+		t.Fatalf("error adding rules: %s", err)
+	}
+
+	firewall, _, err = client.Firewalls.Get(ctx, firewall.ID)
+	if err != nil {
+		t.Fatalf("error getting firewall: %s", err)
+	}
+	if len(firewall.VmIDs) != 1 || len(firewall.Tags) != 1 || len(firewall.InboundRules) != 2 {
+		t.Fatalf("unexpected firewall after additions: %#v", firewall)
+	}
+
+	if _, err := client.Firewalls.RemoveTags(ctx, firewall.ID, "web"); err != nil {
+		t.Fatalf("error removing tags: %s", err)
+	}
+	firewall, _, err = client.Firewalls.Get(ctx, firewall.ID)
+	if err != nil {
+		t.Fatalf("error getting firewall: %s", err)
+	}
+	if len(firewall.Tags) != 0 {
+		t.Errorf("expected no tags, got %v", firewall.Tags)
+	}
+
+	// Updates replace the rules, VMs and tags of the firewall.
+	firewall, _, err = client.Firewalls.Update(ctx, firewall.ID, &goApiAbrha.FirewallRequest{
+		Name: "renamed",
+		Tags: []string{"db"},
+	})
+	if err != nil {
+		t.Fatalf("error updating firewall: %s", err)
+	}
+	if firewall.Name != "renamed" || len(firewall.InboundRules) != 0 || len(firewall.VmIDs) != 0 || len(firewall.Tags) != 1 {
+		t.Errorf("unexpected firewall after update: %#v", firewall)
+	}
+
+	if _, err := client.Firewalls.Delete(ctx, firewall.ID); err != nil {
+		t.Fatalf("error deleting firewall: %s", err)
+	}
+	_, resp, err := client.Firewalls.Get(ctx, firewall.ID)
+	if err == nil || resp == nil || resp.StatusCode != 404 {
+		t.Fatalf("expected 404 after delete, got %v", err)
+	}
+}
+
+func TestFakeAPI_VPCs(t *testing.T) {
+	f := acceptance.NewFakeAPI()
+	defer f.Close()
+	client := newFakeAPIClient(t, f)
+	ctx := context.Background()
+
+	vpc, _, err := client.VPCs.Create(ctx, &goApiAbrha.VPCCreateRequest{
+		Name:       "foobar",
+		RegionSlug: "nyc3",
+	})
+	if err != nil {
+		t.Fatalf("error creating vpc: %s", err)
+	}
+	if vpc.IPRange == "" || vpc.Default {
+		t.Errorf("unexpected vpc: %#v", vpc)
+	}
+
+	vpc, _, err = client.VPCs.Update(ctx, vpc.ID, &goApiAbrha.VPCUpdateRequest{Name: "renamed"})
+	if err != nil {
+		t.Fatalf("error updating vpc: %s", err)
+	}
+	if vpc.Name != "renamed" {
+		t.Errorf("expected renamed vpc, got %q", vpc.Name)
+	}
+
+	vm := createFakeVm(t, client, "foobar")
+	defaultVPC, _, err := client.VPCs.Get(ctx, vm.VPCUUID)
+	if err != nil {
+		t.Fatalf("error getting default vpc: %s", err)
+	}
+	if !defaultVPC.Default {
+		t.Errorf("expected the vm to be placed in the default vpc, got %#v", defaultVPC)
+	}
+
+	members, _, err := client.VPCs.ListMembers(ctx, defaultVPC.ID, nil, nil)
+	if err != nil {
+		t.Fatalf("error listing members: %s", err)
+	}
+	if len(members) != 1 || members[0].Name != "foobar" {
+		t.Fatalf("expected the vm as the only member, got %v", members)
+	}
+	if _, err := client.VPCs.Delete(ctx, defaultVPC.ID); err == nil {
+		t.Fatalf("expected an error deleting a vpc with members")
+	}
+
+	if _, err := client.Vms.Delete(ctx, vm.ID); err != nil {
+		t.Fatalf("error deleting vm: %s", err)
+	}
+	if _, err := client.VPCs.Delete(ctx, defaultVPC.ID); err == nil {
+		t.Fatalf("expected an error deleting the default vpc")
+	}
+
+	if _, err := client.VPCs.Delete(ctx, vpc.ID); err != nil {
+		t.Fatalf("error deleting vpc: %s", err)
+	}
+	_, resp, err := client.VPCs.ListMembers(ctx, vpc.ID, nil, nil)
+	if err == nil || resp == nil || resp.StatusCode != 404 {
+		t.Fatalf("expected 404 after delete, got %v", err)
+	}
+}
+
+func TestFakeAPI_ReservedIPs(t *testing.T) {
+	f := acceptance.NewFakeAPI()
+	defer f.Close()
+	client := newFakeAPIClient(t, f)
+	ctx := context.Background()
+
+	vm := createFakeVm(t, client, "foobar")
+
+	ip, _, err := client.ReservedIPs.Create(ctx, &goApiAbrha.ReservedIPCreateRequest{Region: "nyc3"})
+	if err != nil {
+		t.Fatalf("error creating reserved ip: %s", err)
+	}
+	if ip.IP == "" || ip.Vm != nil {
+		t.Fatalf("unexpected reserved ip: %#v", ip)
+	}
+
+	if _, _, err := client.ReservedIPActions.Assign(ctx, ip.IP, vm.ID); err != nil {
+		t.Fatalf("error assigning reserved ip: %s", err)
+	}
+
+	// Renames of the Vm are reflected in the embedded Vm.
+	if _, _, err := client.VmActions.Rename(ctx, vm.ID, "renamed"); err != nil {
+		t.Fatalf("error renaming vm: %s", err)
+	}
+	ip, _, err = client.ReservedIPs.Get(ctx, ip.IP)
+	if err != nil {
+		t.Fatalf("error getting reserved ip: %s", err)
+	}
+	if ip.Vm == nil || ip.Vm.Name != "renamed" {
+		t.Fatalf("expected the reserved ip to be assigned to the renamed vm, got %#v", ip.Vm)
+	}
+
+	action, _, err := client.ReservedIPActions.Unassign(ctx, ip.IP)
+	if err != nil {
+		t.Fatalf("error unassigning reserved ip: %s", err)
+	}
+	action, _, err = client.ReservedIPActions.Get(ctx, ip.IP, action.ID)
+	if err != nil {
+		t.Fatalf("error getting action: %s", err)
+	}
+	if action.Status != goApiAbrha.ActionCompleted {
+		t.Errorf("expected completed action, got %q", action.Status)
+	}
+	actions, _, err := client.ReservedIPActions.List(ctx, ip.IP, nil)
+	if err != nil {
+		t.Fatalf("error listing actions: %s", err)
+	}
+	if len(actions) != 2 {
+		t.Errorf("expected 2 actions, got %d", len(actions))
+	}
+
+	ip, _, err = client.ReservedIPs.Get(ctx, ip.IP)
+	if err != nil {
+		t.Fatalf("error getting reserved ip: %s", err)
+	}
+	if ip.Vm != nil {
+		t.Errorf("expected the reserved ip to be unassigned, got %#v", ip.Vm)
+	}
+
+	if _, _, err := client.ReservedIPActions.Assign(ctx, ip.IP, "missing"); err == nil {
+		t.Fatalf("expected an error assigning to a missing vm")
+	}
+
+	if _, err := client.ReservedIPs.Delete(ctx, ip.IP); err != nil {
+		t.Fatalf("error deleting reserved ip: %s", err)
+	}
+	_, resp, err := client.ReservedIPs.Get(ctx, ip.IP)
+	if err == nil || resp == nil || resp.StatusCode != 404 {
+		t.Fatalf("expected 404 after delete, got %v", err)
+	}
+}
+
+func TestFakeAPI_Tags(t *testing.T) {
+	f := acceptance.NewFakeAPI()
+	defer f.Close()
+	client := newFakeAPIClient(t, f)
+	ctx := context.Background()
+
+	vm := createFakeVm(t, client, "foobar")
+
+	tag, _, err := client.Tags.Create(ctx, &goApiAbrha.TagCreateRequest{Name: "web"})
+	if err != nil {
+		t.Fatalf("error creating tag: %s", err)
+	}
+	if tag.Resources.Count != 0 {
+		t.Errorf("expected an unused tag, got %#v", tag.Resources)
+	}
+
+	if _, err := client.Tags.TagResources(ctx, "web", &goApiAbrha.TagResourcesRequest{
+		Resources: []goApiAbrha.Resource{{ID: vm.ID, Type: goApiAbrha.VmResourceType}},
+	}); err != nil {
+		t.Fatalf("error tagging resources: %s", err)
+	}
+
+	tag, _, err = client.Tags.Get(ctx, "web")
+	if err != nil {
+		t.Fatalf("error getting tag: %s", err)
+	}
+	if tag.Resources.Count != 1 || tag.Resources.Vms.Count != 1 {
+		t.Errorf("expected the tag to have 1 vm, got %#v", tag.Resources)
+	}
+
+	if _, err := client.Tags.UntagResources(ctx, "web", &goApiAbrha.UntagResourcesRequest{
+		Resources: []goApiAbrha.Resource{{ID: vm.ID, Type: goApiAbrha.VmResourceType}},
+	}); err != nil {
+		t.Fatalf("error untagging resources: %s", err)
+	}
+	tags, _, err := client.Tags.List(ctx, nil)
+	if err != nil {
+		t.Fatalf("error listing tags: %s", err)
+	}
+	if len(tags) != 1 || tags[0].Resources.Count != 0 {
+		t.Errorf("expected 1 unused tag, got %#v", tags)
+	}
+
+	if _, err := client.Tags.TagResources(ctx, "web", &goApiAbrha.TagResourcesRequest{
+		Resources: []goApiAbrha.Resource{{ID: vm.ID, Type: goApiAbrha.VmResourceType}},
+	}); err != nil {
+		t.Fatalf("error tagging resources: %s", err)
+	}
+	if _, err := client.Tags.Delete(ctx, "web"); err != nil {
+		t.Fatalf("error deleting tag: %s", err)
+	}
+	vm, _, err = client.Vms.Get(ctx, vm.ID)
+	if err != nil {
+		t.Fatalf("error getting vm: %s", err)
+	}
+	if len(vm.Tags) != 0 {
+		t.Errorf("expected the deleted tag to be removed from the vm, got %v", vm.Tags)
+	}
+	_, resp, err := client.Tags.Get(ctx, "web")
+	if err == nil || resp == nil || resp.StatusCode != 404 {
+		t.Fatalf("expected 404 after delete, got %v", err)
+	}
+}
+
+func TestFakeAPI_UseFakeAPI(t *testing.T) {
+	f := acceptance.UseFakeAPI(t)
+	vm := createFakeVm(t, newFakeAPIClient(t, f), "foobar")
+
+	// The pre-check configures the shared test provider, which must then
+	// talk to the fake API.
+	acceptance.TestAccPreCheck(t)
+
+	client := acceptance.TestAccProvider.Meta().(*config.CombinedConfig).GoApiAbrhaClient()
+	got, _, err := client.Vms.Get(context.Background(), vm.ID)
+	if err != nil {
+		t.Fatalf("error getting vm through the configured provider: %s", err)
+	}
+	if got.Name != "foobar" {
+		t.Errorf("expected vm foobar, got %q", got.Name)
+	}
+}
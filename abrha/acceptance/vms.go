@@ -5,6 +5,7 @@ import (
 	"fmt"
 	"log"
 	"strings"
+	"time"
 
 	goApiAbrha "github.com/abrhacom/go-api-abrha"
 	"github.com/abrhacom/terraform-provider-abrha/abrha/config"
@@ -106,7 +107,7 @@ func takeSnapshotOfVm(snapName string, intSuffix int, vm *goApiAbrha.Vm) error {
 	if err != nil {
 		return err
 	}
-	err = util.WaitForAction(client, action)
+	err = util.WaitForAction(context.Background(), client, action, 60*time.Minute)
 	if err != nil {
 		return err
 	}
@@ -0,0 +1,61 @@
+package app
+
+import (
+	"context"
+	"fmt"
+	"log"
+	"net/http"
+
+	goApiAbrha "github.com/abrhacom/go-api-abrha"
+	"github.com/abrhacom/terraform-provider-abrha/abrha/config"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/schema"
+)
+
+// isAppSpecValidationError reports whether an error returned when proposing
+// an app spec was caused by the spec itself rather than by a failure of the
+// API, such as missing credentials, rate limiting or an unknown app.
+func isAppSpecValidationError(resp *goApiAbrha.Response) bool {
+	if resp == nil {
+		return false
+	}
+
+	return resp.StatusCode == http.StatusBadRequest || resp.StatusCode == http.StatusUnprocessableEntity
+}
+
+// validateAppSpecProposal validates a changed spec against the API during plan
+// and records the projected monthly cost of the app. Specs that still contain
+// unknown values are skipped, as they cannot be proposed until apply.
+func validateAppSpecProposal() schema.CustomizeDiffFunc {
+	return schema.CustomizeDiffFunc(func(ctx context.Context, diff *schema.ResourceDiff, v interface{}) error {
+		if diff.Id() != "" && !diff.HasChange("spec") {
+			return nil
+		}
+
+		rawSpec := diff.GetRawConfig().GetAttr("spec")
+		if rawSpec.IsNull() || !rawSpec.IsWhollyKnown() {
+			return nil
+		}
+
+		client := v.(*config.CombinedConfig).GoApiAbrhaClient()
+		proposeRequest := &goApiAbrha.AppProposeRequest{
+			Spec:  expandAppSpec(diff.Get("spec").([]interface{})),
+			AppID: diff.Id(),
+		}
+
+		proposal, resp, err := client.Apps.Propose(ctx, proposeRequest)
+		if err != nil {
+			if isAppSpecValidationError(resp) {
+				return fmt.Errorf("invalid app spec: %s", err)
+			}
+
+			log.Printf("[WARN] Unable to validate app spec, skipping plan-time validation: %s", err)
+			return nil
+		}
+
+		if diff.Id() == "" && !proposal.AppNameAvailable {
+			return fmt.Errorf("app name %q is not available, suggested name: %q", proposeRequest.Spec.Name, proposal.AppNameSuggestion)
+		}
+
+		return diff.SetNew("projected_monthly_cost", float64(proposal.AppCost))
+	})
+}
@@ -0,0 +1,36 @@
+package app
+
+import (
+	"net/http"
+	"testing"
+
+	goApiAbrha "github.com/abrhacom/go-api-abrha"
+)
+
+func TestIsAppSpecValidationError(t *testing.T) {
+	t.Parallel()
+
+	tt := []struct {
+		statusCode int
+		expected   bool
+	}{
+		{statusCode: http.StatusBadRequest, expected: true},
+		{statusCode: http.StatusUnprocessableEntity, expected: true},
+		{statusCode: http.StatusUnauthorized, expected: false},
+		{statusCode: http.StatusForbidden, expected: false},
+		{statusCode: http.StatusNotFound, expected: false},
+		{statusCode: http.StatusTooManyRequests, expected: false},
+		{statusCode: http.StatusInternalServerError, expected: false},
+	}
+
+	for _, tc := range tt {
+		resp := &goApiAbrha.Response{Response: &http.Response{StatusCode: tc.statusCode}}
+		if got := isAppSpecValidationError(resp); got != tc.expected {
+			t.Errorf("isAppSpecValidationError(%d) = %t, expected %t", tc.statusCode, got, tc.expected)
+		}
+	}
+
+	if isAppSpecValidationError(nil) {
+		t.Error("isAppSpecValidationError(nil) = true, expected false")
+	}
+}
@@ -0,0 +1,129 @@
+package app
+
+import (
+	"context"
+	"fmt"
+
+	goApiAbrha "github.com/abrhacom/go-api-abrha"
+	"github.com/abrhacom/terraform-provider-abrha/abrha/config"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/schema"
+)
+
+func appSchema() map[string]*schema.Schema {
+	return map[string]*schema.Schema{
+		"id": {
+			Type:        schema.TypeString,
+			Description: "id of the app",
+		},
+		"name": {
+			Type:        schema.TypeString,
+			Description: "name of the app",
+		},
+		"urn": {
+			Type:        schema.TypeString,
+			Description: "the uniform resource name for the app",
+		},
+		"region": {
+			Type:        schema.TypeString,
+			Description: "the region that the app is deployed in",
+		},
+		"tier_slug": {
+			Type:        schema.TypeString,
+			Description: "the tier of the app",
+		},
+		"default_ingress": {
+			Type:        schema.TypeString,
+			Description: "the default URL to access the app",
+		},
+		"live_url": {
+			Type:        schema.TypeString,
+			Description: "the live URL of the app",
+		},
+		"live_domain": {
+			Type:        schema.TypeString,
+			Description: "the live domain of the app",
+		},
+		"active_deployment_id": {
+			Type:        schema.TypeString,
+			Description: "the ID of the app's currently active deployment",
+		},
+		"project_id": {
+			Type:        schema.TypeString,
+			Description: "the id of the project that the app is assigned to",
+		},
+		"created_at": {
+			Type:        schema.TypeString,
+			Description: "the creation date of the app",
+		},
+		"updated_at": {
+			Type:        schema.TypeString,
+			Description: "the date the app was last updated",
+		},
+	}
+}
+
+func getAbrhaApps(meta interface{}, extra map[string]interface{}) ([]interface{}, error) {
+	client := meta.(*config.CombinedConfig).GoApiAbrhaClient()
+
+	opts := &goApiAbrha.ListOptions{
+		Page:    1,
+		PerPage: 200,
+	}
+
+	var appList []interface{}
+
+	for {
+		apps, resp, err := client.Apps.List(context.Background(), opts)
+		if err != nil {
+			return nil, fmt.Errorf("Error retrieving apps: %s", err)
+		}
+
+		for _, app := range apps {
+			appList = append(appList, app)
+		}
+
+		if resp.Links == nil || resp.Links.IsLastPage() {
+			break
+		}
+
+		page, err := resp.Links.CurrentPage()
+		if err != nil {
+			return nil, fmt.Errorf("Error retrieving apps: %s", err)
+		}
+
+		opts.Page = page + 1
+	}
+
+	return appList, nil
+}
+
+func flattenAbrhaApp(rawApp, meta interface{}, extra map[string]interface{}) (map[string]interface{}, error) {
+	app := rawApp.(*goApiAbrha.App)
+
+	flattenedApp := map[string]interface{}{
+		"id":                   app.ID,
+		"urn":                  app.URN(),
+		"tier_slug":            app.TierSlug,
+		"default_ingress":      app.DefaultIngress,
+		"live_url":             app.LiveURL,
+		"live_domain":          app.LiveDomain,
+		"active_deployment_id": "",
+		"project_id":           app.ProjectID,
+		"created_at":           app.CreatedAt.UTC().String(),
+		"updated_at":           app.UpdatedAt.UTC().String(),
+	}
+
+	if app.Spec != nil {
+		flattenedApp["name"] = app.Spec.Name
+	}
+
+	if app.Region != nil {
+		flattenedApp["region"] = app.Region.Slug
+	}
+
+	if app.ActiveDeployment != nil {
+		flattenedApp["active_deployment_id"] = app.ActiveDeployment.ID
+	}
+
+	return flattenedApp, nil
+}
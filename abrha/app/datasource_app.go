@@ -88,6 +88,21 @@ func DataSourceAbrhaApp() *schema.Resource {
 				Computed:    true,
 				Description: "The date and time of when the App was created",
 			},
+			"last_deployment_id": {
+				Type:        schema.TypeString,
+				Computed:    true,
+				Description: "The ID of the App's most recent deployment",
+			},
+			"last_deployment_phase": {
+				Type:        schema.TypeString,
+				Computed:    true,
+				Description: "The phase of the App's most recent deployment",
+			},
+			"last_deployment_cause": {
+				Type:        schema.TypeString,
+				Computed:    true,
+				Description: "The cause of the App's most recent deployment",
+			},
 		},
 	}
 }
@@ -0,0 +1,104 @@
+package app
+
+import (
+	"context"
+	"encoding/json"
+
+	goApiAbrha "github.com/abrhacom/go-api-abrha"
+	"github.com/abrhacom/terraform-provider-abrha/abrha/config"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/diag"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/id"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/schema"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/validation"
+)
+
+func DataSourceAbrhaAppSpecProposal() *schema.Resource {
+	return &schema.Resource{
+		ReadContext: dataSourceAbrhaAppSpecProposalRead,
+		Schema: map[string]*schema.Schema{
+			"spec": {
+				Type:        schema.TypeList,
+				Required:    true,
+				MaxItems:    1,
+				Description: "The proposed Abrha App Platform Spec",
+				Elem: &schema.Resource{
+					Schema: appSpecSchema(true),
+				},
+			},
+			"app_id": {
+				Type:         schema.TypeString,
+				Optional:     true,
+				ValidateFunc: validation.NoZeroValues,
+				Description:  "The ID of an existing app the spec is proposed as an update for",
+			},
+
+			// Computed attributes
+			"app_cost": {
+				Type:        schema.TypeFloat,
+				Computed:    true,
+				Description: "The monthly cost of the proposed app in USD",
+			},
+			"app_name_available": {
+				Type:        schema.TypeBool,
+				Computed:    true,
+				Description: "Whether the app name is available",
+			},
+			"app_name_suggestion": {
+				Type:        schema.TypeString,
+				Computed:    true,
+				Description: "A suggested available name if the app name is unavailable",
+			},
+			"app_is_starter": {
+				Type:        schema.TypeBool,
+				Computed:    true,
+				Description: "Whether the app is a starter tier app",
+			},
+			"existing_starter_apps": {
+				Type:        schema.TypeString,
+				Computed:    true,
+				Description: "The number of existing starter tier apps the account has",
+			},
+			"max_free_starter_apps": {
+				Type:        schema.TypeString,
+				Computed:    true,
+				Description: "The maximum number of free starter apps the account can have",
+			},
+			"proposed_spec_json": {
+				Type:        schema.TypeString,
+				Computed:    true,
+				Sensitive:   true,
+				Description: "The spec as it would be applied by the API, encoded as JSON. Marked sensitive as it includes secret values of the spec, such as environment variables and registry credentials.",
+			},
+		},
+	}
+}
+
+func dataSourceAbrhaAppSpecProposalRead(ctx context.Context, d *schema.ResourceData, meta interface{}) diag.Diagnostics {
+	client := meta.(*config.CombinedConfig).GoApiAbrhaClient()
+
+	proposeRequest := &goApiAbrha.AppProposeRequest{
+		Spec:  expandAppSpec(d.Get("spec").([]interface{})),
+		AppID: d.Get("app_id").(string),
+	}
+
+	proposal, _, err := client.Apps.Propose(ctx, proposeRequest)
+	if err != nil {
+		return diag.Errorf("Error proposing app spec: %s", err)
+	}
+
+	proposedSpec, err := json.Marshal(proposal.Spec)
+	if err != nil {
+		return diag.Errorf("Error encoding proposed app spec: %s", err)
+	}
+
+	d.SetId(id.UniqueId())
+	d.Set("app_cost", float64(proposal.AppCost))
+	d.Set("app_name_available", proposal.AppNameAvailable)
+	d.Set("app_name_suggestion", proposal.AppNameSuggestion)
+	d.Set("app_is_starter", proposal.AppIsStarter)
+	d.Set("existing_starter_apps", proposal.ExistingStarterApps)
+	d.Set("max_free_starter_apps", proposal.MaxFreeStarterApps)
+	d.Set("proposed_spec_json", string(proposedSpec))
+
+	return nil
+}
@@ -0,0 +1,50 @@
+package app_test
+
+import (
+	"fmt"
+	"regexp"
+	"testing"
+
+	"github.com/abrhacom/terraform-provider-abrha/abrha/acceptance"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/resource"
+)
+
+func TestAccDataSourceAbrhaAppSpecProposal_Basic(t *testing.T) {
+	appName := acceptance.RandomTestName()
+
+	resource.ParallelTest(t, resource.TestCase{
+		PreCheck:          func() { acceptance.TestAccPreCheck(t) },
+		ProviderFactories: acceptance.TestAccProviderFactories,
+		Steps: []resource.TestStep{
+			{
+				Config: fmt.Sprintf(testAccCheckDataSourceAbrhaAppSpecProposalConfig, appName),
+				Check: resource.ComposeTestCheckFunc(
+					resource.TestCheckResourceAttr("data.abrha_app_spec_proposal.foobar", "app_name_available", "true"),
+					resource.TestCheckResourceAttrSet("data.abrha_app_spec_proposal.foobar", "app_cost"),
+					resource.TestMatchResourceAttr("data.abrha_app_spec_proposal.foobar", "proposed_spec_json",
+						regexp.MustCompile(appName)),
+				),
+			},
+		},
+	})
+}
+
+const testAccCheckDataSourceAbrhaAppSpecProposalConfig = `
+data "abrha_app_spec_proposal" "foobar" {
+  spec {
+    name   = "%s"
+    region = "ams"
+
+    service {
+      name               = "go-service"
+      environment_slug   = "go"
+      instance_count     = 1
+      instance_size_slug = "basic-xxs"
+
+      git {
+        repo_clone_url = "https://github.com/abrha/sample-golang.git"
+        branch         = "main"
+      }
+    }
+  }
+}`
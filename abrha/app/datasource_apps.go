@@ -0,0 +1,17 @@
+package app
+
+import (
+	"github.com/abrhacom/terraform-provider-abrha/internal/datalist"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/schema"
+)
+
+func DataSourceAbrhaApps() *schema.Resource {
+	dataListConfig := &datalist.ResourceConfig{
+		RecordSchema:        appSchema(),
+		ResultAttributeName: "apps",
+		GetRecords:          getAbrhaApps,
+		FlattenRecord:       flattenAbrhaApp,
+	}
+
+	return datalist.NewResource(dataListConfig)
+}
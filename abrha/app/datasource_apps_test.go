@@ -0,0 +1,45 @@
+package app_test
+
+import (
+	"fmt"
+	"testing"
+
+	"github.com/abrhacom/terraform-provider-abrha/abrha/acceptance"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/resource"
+)
+
+func TestAccDataSourceAbrhaApps_Basic(t *testing.T) {
+	appName := acceptance.RandomTestName()
+	resourceConfig := fmt.Sprintf(testAccCheckAbrhaAppConfig_basic, appName)
+	datasourceConfig := fmt.Sprintf(`
+data "abrha_apps" "result" {
+  filter {
+    key    = "name"
+    values = ["%s"]
+  }
+}
+`, appName)
+
+	resource.ParallelTest(t, resource.TestCase{
+		PreCheck:          func() { acceptance.TestAccPreCheck(t) },
+		ProviderFactories: acceptance.TestAccProviderFactories,
+		Steps: []resource.TestStep{
+			{
+				Config: resourceConfig,
+			},
+			{
+				Config: resourceConfig + datasourceConfig,
+				Check: resource.ComposeTestCheckFunc(
+					resource.TestCheckResourceAttr("data.abrha_apps.result", "apps.#", "1"),
+					resource.TestCheckResourceAttr("data.abrha_apps.result", "apps.0.name", appName),
+					resource.TestCheckResourceAttrPair("data.abrha_apps.result", "apps.0.id", "abrha_app.foobar", "id"),
+					resource.TestCheckResourceAttrPair("data.abrha_apps.result", "apps.0.urn", "abrha_app.foobar", "urn"),
+					resource.TestCheckResourceAttrPair("data.abrha_apps.result", "apps.0.default_ingress", "abrha_app.foobar", "default_ingress"),
+				),
+			},
+			{
+				Config: resourceConfig,
+			},
+		},
+	})
+}
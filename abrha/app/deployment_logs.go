@@ -0,0 +1,185 @@
+package app
+
+import (
+	"context"
+	"fmt"
+	"io"
+	"log"
+	"net/http"
+	"strings"
+	"time"
+
+	goApiAbrha "github.com/abrhacom/go-api-abrha"
+)
+
+const (
+	// deploymentLogTailLines is the number of log lines included for each
+	// failed component when a deployment fails.
+	deploymentLogTailLines = 50
+	// deploymentLogMaxBytes caps how much of a log file is read.
+	deploymentLogMaxBytes = 1 << 20
+)
+
+// The log URLs returned by the API are pre-signed, so they are fetched with a
+// plain client rather than the API client, which would attach credentials.
+var deploymentLogHTTPClient = &http.Client{Timeout: 30 * time.Second}
+
+// failedDeploymentStep describes a step of a deployment that errored.
+type failedDeploymentStep struct {
+	Name      string
+	Component string
+	Reason    string
+	LogTypes  []goApiAbrha.AppLogType
+}
+
+// findFailedDeploymentSteps walks the deployment progress and returns the
+// innermost steps with an error status. The top level step is used to decide
+// which log types are relevant for the failure.
+func findFailedDeploymentSteps(progress *goApiAbrha.DeploymentProgress) []failedDeploymentStep {
+	if progress == nil {
+		return nil
+	}
+
+	var failed []failedDeploymentStep
+	for _, step := range progress.Steps {
+		if step == nil || step.Status != goApiAbrha.DeploymentProgressStepStatus_Error {
+			continue
+		}
+
+		failed = append(failed, collectFailedSteps(step, deploymentStepLogTypes(step.Name))...)
+	}
+
+	return failed
+}
+
+func collectFailedSteps(step *goApiAbrha.DeploymentProgressStep, logTypes []goApiAbrha.AppLogType) []failedDeploymentStep {
+	var failed []failedDeploymentStep
+	for _, s := range step.Steps {
+		if s != nil && s.Status == goApiAbrha.DeploymentProgressStepStatus_Error {
+			failed = append(failed, collectFailedSteps(s, logTypes)...)
+		}
+	}
+
+	if len(failed) > 0 {
+		return failed
+	}
+
+	f := failedDeploymentStep{
+		Name:      step.Name,
+		Component: step.ComponentName,
+		LogTypes:  logTypes,
+	}
+	if step.Reason != nil {
+		f.Reason = strings.TrimSpace(fmt.Sprintf("%s %s", step.Reason.Code, step.Reason.Message))
+	}
+
+	return []failedDeploymentStep{f}
+}
+
+func deploymentStepLogTypes(name string) []goApiAbrha.AppLogType {
+	switch {
+	case strings.HasPrefix(name, "build"):
+		return []goApiAbrha.AppLogType{goApiAbrha.AppLogTypeBuild}
+	case strings.HasPrefix(name, "deploy"):
+		return []goApiAbrha.AppLogType{goApiAbrha.AppLogTypeDeploy}
+	default:
+		return []goApiAbrha.AppLogType{goApiAbrha.AppLogTypeBuild, goApiAbrha.AppLogTypeDeploy}
+	}
+}
+
+// deploymentFailureError builds an error for a failed deployment including the
+// failing steps and a tail of the build or deploy logs of each failed
+// component. Problems retrieving the logs are noted but never replace the
+// deployment error itself.
+func deploymentFailureError(ctx context.Context, client *goApiAbrha.Client, appID string, deployment *goApiAbrha.Deployment) error {
+	var b strings.Builder
+	fmt.Fprintf(&b, "error deploying app (%s) (deployment ID: %s)", appID, deployment.ID)
+
+	failed := findFailedDeploymentSteps(deployment.Progress)
+	if len(failed) == 0 {
+		fmt.Fprintf(&b, ":\n%s", goApiAbrha.Stringify(deployment.Progress))
+		return fmt.Errorf("%s", b.String())
+	}
+
+	fetched := make(map[string]bool)
+	for _, step := range failed {
+		fmt.Fprintf(&b, "\n\nfailed step: %s", step.Name)
+		if step.Component != "" {
+			fmt.Fprintf(&b, " (component: %s)", step.Component)
+		}
+		if step.Reason != "" {
+			fmt.Fprintf(&b, "\nreason: %s", step.Reason)
+		}
+
+		for _, logType := range step.LogTypes {
+			key := step.Component + "/" + string(logType)
+			if fetched[key] {
+				continue
+			}
+			fetched[key] = true
+
+			logs, err := getDeploymentLogTail(ctx, client, appID, deployment.ID, step.Component, logType, deploymentLogTailLines)
+			if err != nil {
+				log.Printf("[WARN] Unable to retrieve %s logs for app (%s) deployment (%s): %s", logType, appID, deployment.ID, err)
+				fmt.Fprintf(&b, "\n%s logs unavailable: %s", strings.ToLower(string(logType)), err)
+				continue
+			}
+			if logs == "" {
+				continue
+			}
+
+			fmt.Fprintf(&b, "\n%s logs (tail):\n%s", strings.ToLower(string(logType)), logs)
+		}
+	}
+
+	return fmt.Errorf("%s", b.String())
+}
+
+// getDeploymentLogTail retrieves the logs of the given type for a deployment
+// and returns at most the last tailLines lines.
+func getDeploymentLogTail(ctx context.Context, client *goApiAbrha.Client, appID, deploymentID, component string, logType goApiAbrha.AppLogType, tailLines int) (string, error) {
+	appLogs, _, err := client.Apps.GetLogs(ctx, appID, deploymentID, component, logType, false, tailLines)
+	if err != nil {
+		return "", err
+	}
+
+	logURL := appLogs.LiveURL
+	if len(appLogs.HistoricURLs) > 0 {
+		logURL = appLogs.HistoricURLs[0]
+	}
+	if logURL == "" {
+		return "", nil
+	}
+
+	req, err := http.NewRequestWithContext(ctx, http.MethodGet, logURL, nil)
+	if err != nil {
+		return "", err
+	}
+
+	resp, err := deploymentLogHTTPClient.Do(req)
+	if err != nil {
+		return "", err
+	}
+	defer resp.Body.Close()
+
+	if resp.StatusCode != http.StatusOK {
+		return "", fmt.Errorf("unexpected status fetching logs: %s", resp.Status)
+	}
+
+	body, err := io.ReadAll(io.LimitReader(resp.Body, deploymentLogMaxBytes))
+	if err != nil {
+		return "", err
+	}
+
+	return trimLogTail(string(body), tailLines), nil
+}
+
+// trimLogTail returns at most the last n lines of logs.
+func trimLogTail(logs string, n int) string {
+	lines := strings.Split(strings.TrimRight(logs, "\n"), "\n")
+	if len(lines) > n {
+		lines = lines[len(lines)-n:]
+	}
+
+	return strings.Join(lines, "\n")
+}
@@ -0,0 +1,107 @@
+package app
+
+import (
+	"context"
+	"fmt"
+	"net/http"
+	"net/http/httptest"
+	"strings"
+	"testing"
+
+	goApiAbrha "github.com/abrhacom/go-api-abrha"
+)
+
+func TestTrimLogTail(t *testing.T) {
+	t.Parallel()
+
+	tt := []struct {
+		logs     string
+		n        int
+		expected string
+	}{
+		{logs: "", n: 3, expected: ""},
+		{logs: "a\nb\n", n: 3, expected: "a\nb"},
+		{logs: "a\nb\nc\nd\ne\n", n: 3, expected: "c\nd\ne"},
+	}
+
+	for _, tc := range tt {
+		if got := trimLogTail(tc.logs, tc.n); got != tc.expected {
+			t.Errorf("trimLogTail(%q, %d) = %q, expected %q", tc.logs, tc.n, got, tc.expected)
+		}
+	}
+}
+
+func TestDeploymentFailureError(t *testing.T) {
+	t.Parallel()
+
+	mux := http.NewServeMux()
+	server := httptest.NewServer(mux)
+	defer server.Close()
+
+	mux.HandleFunc("/api/public/v1/apps/app-1/deployments/dep-1/logs", func(w http.ResponseWriter, r *http.Request) {
+		if got := r.URL.Query().Get("type"); got != "BUILD" {
+			t.Errorf("expected BUILD logs, got %s", got)
+		}
+		if got := r.URL.Query().Get("component_name"); got != "web" {
+			t.Errorf("expected logs for component web, got %s", got)
+		}
+
+		w.Header().Set("Content-Type", "application/json")
+		fmt.Fprintf(w, `{"historic_urls": ["%s/logs/build.txt"]}`, server.URL)
+	})
+	mux.HandleFunc("/logs/build.txt", func(w http.ResponseWriter, r *http.Request) {
+		if r.Header.Get("Authorization") != "" {
+			t.Error("expected log download without credentials")
+		}
+		fmt.Fprint(w, "step 1\nstep 2\nnpm ERR! missing script: build\n")
+	})
+
+	client, err := goApiAbrha.New(nil, goApiAbrha.SetBaseURL(server.URL+"/"))
+	if err != nil {
+		t.Fatalf("error creating client: %s", err)
+	}
+
+	deployment := &goApiAbrha.Deployment{
+		ID: "dep-1",
+		Progress: &goApiAbrha.DeploymentProgress{
+			ErrorSteps: 1,
+			Steps: []*goApiAbrha.DeploymentProgressStep{
+				{
+					Name:   "build",
+					Status: goApiAbrha.DeploymentProgressStepStatus_Error,
+					Steps: []*goApiAbrha.DeploymentProgressStep{
+						{
+							Name:          "build-web",
+							ComponentName: "web",
+							Status:        goApiAbrha.DeploymentProgressStepStatus_Error,
+							Reason: &goApiAbrha.DeploymentProgressStepReason{
+								Code:    "BuildJobFailed",
+								Message: "Your build job failed.",
+							},
+						},
+					},
+				},
+				{
+					Name:   "deploy",
+					Status: goApiAbrha.DeploymentProgressStepStatus_Pending,
+				},
+			},
+		},
+	}
+
+	err = deploymentFailureError(context.Background(), client, "app-1", deployment)
+	if err == nil {
+		t.Fatal("expected an error")
+	}
+
+	for _, expected := range []string{
+		"deployment ID: dep-1",
+		"failed step: build-web (component: web)",
+		"reason: BuildJobFailed Your build job failed.",
+		"npm ERR! missing script: build",
+	} {
+		if !strings.Contains(err.Error(), expected) {
+			t.Errorf("expected error to contain %q, got:\n%s", expected, err)
+		}
+	}
+}
@@ -97,6 +97,30 @@ func ResourceAbrhaApp() *schema.Resource {
 				Description: "The ID the App's currently active deployment",
 			},
 
+			"last_deployment_id": {
+				Type:        schema.TypeString,
+				Computed:    true,
+				Description: "The ID of the App's most recent deployment",
+			},
+
+			"last_deployment_phase": {
+				Type:        schema.TypeString,
+				Computed:    true,
+				Description: "The phase of the App's most recent deployment",
+			},
+
+			"last_deployment_cause": {
+				Type:        schema.TypeString,
+				Computed:    true,
+				Description: "The cause of the App's most recent deployment",
+			},
+
+			"projected_monthly_cost": {
+				Type:        schema.TypeFloat,
+				Computed:    true,
+				Description: "The projected monthly cost of the app in USD, calculated when a change to the spec is planned. It is not set for imported apps until their spec next changes.",
+			},
+
 			"urn": {
 				Type:        schema.TypeString,
 				Computed:    true,
@@ -119,6 +143,8 @@ func ResourceAbrhaApp() *schema.Resource {
 		Timeouts: &schema.ResourceTimeout{
 			Create: schema.DefaultTimeout(30 * time.Minute),
 		},
+
+		CustomizeDiff: validateAppSpecProposal(),
 	}
 }
 
@@ -140,7 +166,7 @@ func resourceAbrhaAppCreate(ctx context.Context, d *schema.ResourceData, meta in
 	d.SetId(app.ID)
 	log.Printf("[DEBUG] Waiting for app (%s) deployment to become active", app.ID)
 	timeout := d.Timeout(schema.TimeoutCreate)
-	err = waitForAppDeployment(client, app.ID, timeout)
+	err = waitForAppDeployment(ctx, client, app.ID, timeout)
 	if err != nil {
 		return diag.FromErr(err)
 	}
@@ -180,6 +206,21 @@ func resourceAbrhaAppRead(ctx context.Context, d *schema.ResourceData, meta inte
 		return diag.Errorf("Error setting app spec: %#v", err)
 	}
 
+	deployments, _, err := client.Apps.ListDeployments(context.Background(), app.ID, &goApiAbrha.ListOptions{PerPage: 1})
+	if err != nil {
+		return diag.Errorf("Error reading App deployments: %s", err)
+	}
+
+	if len(deployments) > 0 {
+		d.Set("last_deployment_id", deployments[0].ID)
+		d.Set("last_deployment_phase", string(deployments[0].Phase))
+		d.Set("last_deployment_cause", deployments[0].Cause)
+	} else {
+		d.Set("last_deployment_id", "")
+		d.Set("last_deployment_phase", "")
+		d.Set("last_deployment_cause", "")
+	}
+
 	if app.ActiveDeployment != nil {
 		d.Set("active_deployment_id", app.ActiveDeployment.ID)
 	} else {
@@ -221,7 +262,7 @@ func resourceAbrhaAppUpdate(ctx context.Context, d *schema.ResourceData, meta in
 
 		log.Printf("[DEBUG] Waiting for app (%s) deployment to become active", app.ID)
 		timeout := d.Timeout(schema.TimeoutCreate)
-		err = waitForAppDeployment(client, app.ID, timeout)
+		err = waitForAppDeployment(ctx, client, app.ID, timeout)
 		if err != nil {
 			return diag.FromErr(err)
 		}
@@ -245,7 +286,7 @@ func resourceAbrhaAppDelete(ctx context.Context, d *schema.ResourceData, meta in
 	return nil
 }
 
-func waitForAppDeployment(client *goApiAbrha.Client, id string, timeout time.Duration) error {
+func waitForAppDeployment(ctx context.Context, client *goApiAbrha.Client, id string, timeout time.Duration) error {
 	tickerInterval := 10 //10s
 	timeoutSeconds := int(timeout.Seconds())
 	n := 0
@@ -267,7 +308,7 @@ func waitForAppDeployment(client *goApiAbrha.Client, id string, timeout time.Dur
 			// already completed. So instead we need to list all of the
 			// deployments for the application.
 			opts := &goApiAbrha.ListOptions{PerPage: 20}
-			deployments, _, err := client.Apps.ListDeployments(context.Background(), id, opts)
+			deployments, _, err := client.Apps.ListDeployments(ctx, id, opts)
 			if err != nil {
 				return fmt.Errorf("Error trying to read app deployment state: %s", err)
 			}
@@ -281,7 +322,7 @@ func waitForAppDeployment(client *goApiAbrha.Client, id string, timeout time.Dur
 				deploymentID = deployments[0].ID
 			}
 		} else {
-			deployment, _, err := client.Apps.GetDeployment(context.Background(), id, deploymentID)
+			deployment, _, err := client.Apps.GetDeployment(ctx, id, deploymentID)
 			if err != nil {
 				ticker.Stop()
 				return fmt.Errorf("Error trying to read app deployment state: %s", err)
@@ -302,7 +343,7 @@ func waitForAppDeployment(client *goApiAbrha.Client, id string, timeout time.Dur
 
 			if deployment.Progress.ErrorSteps > 0 {
 				ticker.Stop()
-				return fmt.Errorf("error deploying app (%s) (deployment ID: %s):\n%s", id, deployment.ID, goApiAbrha.Stringify(deployment.Progress))
+				return deploymentFailureError(ctx, client, id, deployment)
 			}
 
 			log.Printf("[DEBUG] Waiting for app (%s) deployment (%s) to become active. Phase: %s (%d/%d)",
@@ -0,0 +1,351 @@
+package app
+
+import (
+	"context"
+	"errors"
+	"fmt"
+	"log"
+	"strings"
+	"time"
+
+	goApiAbrha "github.com/abrhacom/go-api-abrha"
+	"github.com/abrhacom/terraform-provider-abrha/abrha/config"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/diag"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/retry"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/schema"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/validation"
+)
+
+// errAppAlertNotFound is returned when an app has no alert matching the
+// component and rule of an abrha_app_alert_destination.
+var errAppAlertNotFound = errors.New("alert not found")
+
+func ResourceAbrhaAppAlertDestination() *schema.Resource {
+	return &schema.Resource{
+		CreateContext: resourceAbrhaAppAlertDestinationCreate,
+		ReadContext:   resourceAbrhaAppAlertDestinationRead,
+		UpdateContext: resourceAbrhaAppAlertDestinationUpdate,
+		DeleteContext: resourceAbrhaAppAlertDestinationDelete,
+		Importer: &schema.ResourceImporter{
+			StateContext: resourceAbrhaAppAlertDestinationImport,
+		},
+
+		Schema: map[string]*schema.Schema{
+			"app_id": {
+				Type:         schema.TypeString,
+				Required:     true,
+				ForceNew:     true,
+				ValidateFunc: validation.NoZeroValues,
+				Description:  "The ID of the app the alert belongs to",
+			},
+			"component_name": {
+				Type:        schema.TypeString,
+				Optional:    true,
+				ForceNew:    true,
+				Description: "The name of the component the alert applies to. Leave empty for app level alerts.",
+			},
+			"rule": {
+				Type:     schema.TypeString,
+				Required: true,
+				ForceNew: true,
+				ValidateFunc: validation.StringInSlice([]string{
+					string(goApiAbrha.AppAlertSpecRule_CPUUtilization),
+					string(goApiAbrha.AppAlertSpecRule_MemUtilization),
+					string(goApiAbrha.AppAlertSpecRule_RestartCount),
+					string(goApiAbrha.AppAlertSpecRule_DeploymentFailed),
+					string(goApiAbrha.AppAlertSpecRule_DeploymentLive),
+					string(goApiAbrha.AppAlertSpecRule_DeploymentStarted),
+					string(goApiAbrha.AppAlertSpecRule_DeploymentCanceled),
+					string(goApiAbrha.AppAlertSpecRule_DomainFailed),
+					string(goApiAbrha.AppAlertSpecRule_DomainLive),
+					string(goApiAbrha.AppAlertSpecRule_FunctionsActivationCount),
+					string(goApiAbrha.AppAlertSpecRule_FunctionsAverageDurationMS),
+					string(goApiAbrha.AppAlertSpecRule_FunctionsErrorRatePerMinute),
+					string(goApiAbrha.AppAlertSpecRule_FunctionsAverageWaitTimeMs),
+					string(goApiAbrha.AppAlertSpecRule_FunctionsErrorCount),
+					string(goApiAbrha.AppAlertSpecRule_FunctionsGBRatePerSecond),
+				}, false),
+				Description: "The rule of the alert the destinations are bound to",
+			},
+			"emails": {
+				Type:        schema.TypeSet,
+				Optional:    true,
+				Elem:        &schema.Schema{Type: schema.TypeString},
+				Description: "Email addresses notified when the alert is triggered",
+			},
+			"slack_webhook": {
+				Type:        schema.TypeSet,
+				Optional:    true,
+				Description: "Slack webhooks notified when the alert is triggered",
+				Elem: &schema.Resource{
+					Schema: map[string]*schema.Schema{
+						"url": {
+							Type:         schema.TypeString,
+							Required:     true,
+							Sensitive:    true,
+							ValidateFunc: validation.IsURLWithHTTPS,
+							Description:  "The URL of the Slack webhook",
+						},
+						"channel": {
+							Type:         schema.TypeString,
+							Required:     true,
+							ValidateFunc: validation.NoZeroValues,
+							Description:  "The name of the Slack channel",
+						},
+					},
+				},
+			},
+
+			// Computed attributes
+			"alert_id": {
+				Type:        schema.TypeString,
+				Computed:    true,
+				Description: "The ID of the alert the destinations are currently bound to",
+			},
+			"phase": {
+				Type:        schema.TypeString,
+				Computed:    true,
+				Description: "The phase of the alert",
+			},
+		},
+
+		Timeouts: &schema.ResourceTimeout{
+			Create: schema.DefaultTimeout(5 * time.Minute),
+			Update: schema.DefaultTimeout(5 * time.Minute),
+		},
+	}
+}
+
+func resourceAbrhaAppAlertDestinationCreate(ctx context.Context, d *schema.ResourceData, meta interface{}) diag.Diagnostics {
+	appID := d.Get("app_id").(string)
+	componentName := d.Get("component_name").(string)
+	rule := d.Get("rule").(string)
+
+	if err := updateAppAlertDestinations(ctx, d, meta, d.Timeout(schema.TimeoutCreate)); err != nil {
+		return diag.FromErr(err)
+	}
+
+	d.SetId(makeAppAlertDestinationID(appID, componentName, rule))
+
+	return resourceAbrhaAppAlertDestinationRead(ctx, d, meta)
+}
+
+func resourceAbrhaAppAlertDestinationRead(ctx context.Context, d *schema.ResourceData, meta interface{}) diag.Diagnostics {
+	client := meta.(*config.CombinedConfig).GoApiAbrhaClient()
+	appID := d.Get("app_id").(string)
+	componentName := d.Get("component_name").(string)
+	rule := d.Get("rule").(string)
+
+	alert, resp, err := findAppAlert(ctx, client, appID, componentName, rule)
+	if err != nil {
+		if errors.Is(err, errAppAlertNotFound) || (resp != nil && resp.StatusCode == 404) {
+			log.Printf("[WARN] App (%s) alert %s for component %q not found, removing from state", appID, rule, componentName)
+			d.SetId("")
+			return nil
+		}
+
+		return diag.Errorf("Error reading app (%s) alerts: %s", appID, err)
+	}
+
+	d.Set("alert_id", alert.ID)
+	d.Set("phase", string(alert.Phase))
+
+	if err := d.Set("emails", alert.Emails); err != nil {
+		return diag.Errorf("Error setting emails: %s", err)
+	}
+
+	if err := d.Set("slack_webhook", flattenAppAlertSlackWebhooks(alert.SlackWebhooks)); err != nil {
+		return diag.Errorf("Error setting slack_webhook: %s", err)
+	}
+
+	return nil
+}
+
+func resourceAbrhaAppAlertDestinationUpdate(ctx context.Context, d *schema.ResourceData, meta interface{}) diag.Diagnostics {
+	if d.HasChanges("emails", "slack_webhook") {
+		if err := updateAppAlertDestinations(ctx, d, meta, d.Timeout(schema.TimeoutUpdate)); err != nil {
+			return diag.FromErr(err)
+		}
+	}
+
+	return resourceAbrhaAppAlertDestinationRead(ctx, d, meta)
+}
+
+func resourceAbrhaAppAlertDestinationDelete(ctx context.Context, d *schema.ResourceData, meta interface{}) diag.Diagnostics {
+	client := meta.(*config.CombinedConfig).GoApiAbrhaClient()
+	appID := d.Get("app_id").(string)
+	componentName := d.Get("component_name").(string)
+	rule := d.Get("rule").(string)
+
+	alert, resp, err := findAppAlert(ctx, client, appID, componentName, rule)
+	if err != nil {
+		if errors.Is(err, errAppAlertNotFound) || (resp != nil && resp.StatusCode == 404) {
+			d.SetId("")
+			return nil
+		}
+
+		return diag.Errorf("Error reading app (%s) alerts: %s", appID, err)
+	}
+
+	log.Printf("[INFO] Removing destinations from app (%s) alert (%s)", appID, alert.ID)
+	_, _, err = client.Apps.UpdateAlertDestinations(ctx, appID, alert.ID, &goApiAbrha.AlertDestinationUpdateRequest{
+		Emails:        []string{},
+		SlackWebhooks: []*goApiAbrha.AppAlertSlackWebhook{},
+	})
+	if err != nil {
+		return diag.Errorf("Error removing destinations from app (%s) alert (%s): %s", appID, alert.ID, err)
+	}
+
+	d.SetId("")
+	return nil
+}
+
+func resourceAbrhaAppAlertDestinationImport(ctx context.Context, d *schema.ResourceData, meta interface{}) ([]*schema.ResourceData, error) {
+	s := strings.Split(d.Id(), ",")
+
+	switch len(s) {
+	case 2:
+		d.Set("app_id", s[0])
+		d.Set("rule", s[1])
+	case 3:
+		d.Set("app_id", s[0])
+		d.Set("component_name", s[1])
+		d.Set("rule", s[2])
+	default:
+		return nil, errors.New("must use the ID of the app, optionally the component name, and the alert rule joined with commas (e.g. `id,rule` or `id,component,rule`)")
+	}
+
+	d.SetId(makeAppAlertDestinationID(d.Get("app_id").(string), d.Get("component_name").(string), d.Get("rule").(string)))
+
+	return []*schema.ResourceData{d}, nil
+}
+
+func makeAppAlertDestinationID(appID string, componentName string, rule string) string {
+	return fmt.Sprintf("%s/alert/%s/%s", appID, componentName, rule)
+}
+
+// findAppAlert looks up an alert by its component and rule. Alert IDs are
+// regenerated by App Platform when the spec changes, so they cannot be used to
+// identify an alert across deployments.
+func findAppAlert(ctx context.Context, client *goApiAbrha.Client, appID, componentName, rule string) (*goApiAbrha.AppAlert, *goApiAbrha.Response, error) {
+	alerts, resp, err := client.Apps.ListAlerts(ctx, appID)
+	if err != nil {
+		return nil, resp, err
+	}
+
+	var matches []*goApiAbrha.AppAlert
+	for _, alert := range alerts {
+		if alert == nil || alert.Spec == nil {
+			continue
+		}
+
+		if alert.ComponentName == componentName && string(alert.Spec.Rule) == rule {
+			matches = append(matches, alert)
+		}
+	}
+
+	switch len(matches) {
+	case 0:
+		return nil, resp, errAppAlertNotFound
+	case 1:
+		return matches[0], resp, nil
+	default:
+		return nil, resp, fmt.Errorf("found %d alerts with rule %s for component %q, expected 1", len(matches), rule, componentName)
+	}
+}
+
+func updateAppAlertDestinations(ctx context.Context, d *schema.ResourceData, meta interface{}, timeout time.Duration) error {
+	client := meta.(*config.CombinedConfig).GoApiAbrhaClient()
+	appID := d.Get("app_id").(string)
+	componentName := d.Get("component_name").(string)
+	rule := d.Get("rule").(string)
+
+	alert, _, err := findAppAlert(ctx, client, appID, componentName, rule)
+	if err != nil {
+		if errors.Is(err, errAppAlertNotFound) {
+			return fmt.Errorf("app (%s) has no %s alert for component %q, it must be defined in the app spec first", appID, rule, componentName)
+		}
+
+		return fmt.Errorf("Error reading app (%s) alerts: %s", appID, err)
+	}
+
+	updateRequest := &goApiAbrha.AlertDestinationUpdateRequest{
+		Emails:        expandAppAlertEmails(d.Get("emails").(*schema.Set).List()),
+		SlackWebhooks: expandAppAlertSlackWebhooks(d.Get("slack_webhook").(*schema.Set).List()),
+	}
+
+	log.Printf("[INFO] Updating destinations of app (%s) alert (%s)", appID, alert.ID)
+	_, _, err = client.Apps.UpdateAlertDestinations(ctx, appID, alert.ID, updateRequest)
+	if err != nil {
+		return fmt.Errorf("Error updating destinations of app (%s) alert (%s): %s", appID, alert.ID, err)
+	}
+
+	return waitForAppAlertActive(ctx, client, appID, componentName, rule, timeout)
+}
+
+func waitForAppAlertActive(ctx context.Context, client *goApiAbrha.Client, appID, componentName, rule string, timeout time.Duration) error {
+	stateConf := &retry.StateChangeConf{
+		Pending: []string{
+			string(goApiAbrha.AppAlertPhase_Unknown),
+			string(goApiAbrha.AppAlertPhase_Pending),
+			string(goApiAbrha.AppAlertPhase_Configuring),
+		},
+		Target: []string{string(goApiAbrha.AppAlertPhase_Active)},
+		Refresh: func() (interface{}, string, error) {
+			alert, _, err := findAppAlert(ctx, client, appID, componentName, rule)
+			if err != nil {
+				return nil, "", err
+			}
+
+			if alert.Phase == goApiAbrha.AppAlertPhase_Error {
+				return nil, "", fmt.Errorf("app (%s) alert (%s) failed to configure destinations", appID, alert.ID)
+			}
+
+			return alert, string(alert.Phase), nil
+		},
+		Delay:      3 * time.Second,
+		Timeout:    timeout,
+		MinTimeout: 3 * time.Second,
+	}
+
+	_, err := stateConf.WaitForStateContext(ctx)
+	return err
+}
+
+func expandAppAlertEmails(config []interface{}) []string {
+	emails := make([]string, 0, len(config))
+	for _, email := range config {
+		emails = append(emails, email.(string))
+	}
+
+	return emails
+}
+
+func expandAppAlertSlackWebhooks(config []interface{}) []*goApiAbrha.AppAlertSlackWebhook {
+	webhooks := make([]*goApiAbrha.AppAlertSlackWebhook, 0, len(config))
+	for _, rawWebhook := range config {
+		webhook := rawWebhook.(map[string]interface{})
+		webhooks = append(webhooks, &goApiAbrha.AppAlertSlackWebhook{
+			URL:     webhook["url"].(string),
+			Channel: webhook["channel"].(string),
+		})
+	}
+
+	return webhooks
+}
+
+func flattenAppAlertSlackWebhooks(webhooks []*goApiAbrha.AppAlertSlackWebhook) []interface{} {
+	result := make([]interface{}, 0, len(webhooks))
+	for _, webhook := range webhooks {
+		if webhook == nil {
+			continue
+		}
+
+		result = append(result, map[string]interface{}{
+			"url":     webhook.URL,
+			"channel": webhook.Channel,
+		})
+	}
+
+	return result
+}
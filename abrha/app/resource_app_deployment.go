@@ -0,0 +1,167 @@
+package app
+
+import (
+	"context"
+	"fmt"
+	"log"
+	"time"
+
+	goApiAbrha "github.com/abrhacom/go-api-abrha"
+	"github.com/abrhacom/terraform-provider-abrha/abrha/config"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/diag"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/retry"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/schema"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/validation"
+)
+
+// ResourceAbrhaAppDeployment redeploys an app. The API offers no way to redeploy
+// the artifacts of a prior deployment, so rolling back is done by reverting the
+// spec of the abrha_app, which deploys it again.
+func ResourceAbrhaAppDeployment() *schema.Resource {
+	return &schema.Resource{
+		CreateContext: resourceAbrhaAppDeploymentCreate,
+		ReadContext:   resourceAbrhaAppDeploymentRead,
+		DeleteContext: resourceAbrhaAppDeploymentDelete,
+
+		Schema: map[string]*schema.Schema{
+			"app_id": {
+				Type:         schema.TypeString,
+				Required:     true,
+				ForceNew:     true,
+				ValidateFunc: validation.NoZeroValues,
+				Description:  "The ID of the app to deploy",
+			},
+			"force_build": {
+				Type:        schema.TypeBool,
+				Optional:    true,
+				ForceNew:    true,
+				Default:     false,
+				Description: "Whether to force a rebuild of the app's components instead of reusing previous build artifacts",
+			},
+			"triggers": {
+				Type:        schema.TypeMap,
+				Optional:    true,
+				ForceNew:    true,
+				Elem:        &schema.Schema{Type: schema.TypeString},
+				Description: "Arbitrary map of values that, when changed, will create a new deployment",
+			},
+
+			// Computed attributes
+			"phase": {
+				Type:        schema.TypeString,
+				Computed:    true,
+				Description: "The phase of the deployment",
+			},
+			"cause": {
+				Type:        schema.TypeString,
+				Computed:    true,
+				Description: "The cause of the deployment",
+			},
+			"created_at": {
+				Type:        schema.TypeString,
+				Computed:    true,
+				Description: "The date and time of when the deployment was created",
+			},
+			"updated_at": {
+				Type:        schema.TypeString,
+				Computed:    true,
+				Description: "The date and time of when the deployment was last updated",
+			},
+		},
+
+		Timeouts: &schema.ResourceTimeout{
+			Create: schema.DefaultTimeout(30 * time.Minute),
+		},
+	}
+}
+
+func resourceAbrhaAppDeploymentCreate(ctx context.Context, d *schema.ResourceData, meta interface{}) diag.Diagnostics {
+	client := meta.(*config.CombinedConfig).GoApiAbrhaClient()
+	appID := d.Get("app_id").(string)
+
+	createRequest := &goApiAbrha.DeploymentCreateRequest{
+		ForceBuild: d.Get("force_build").(bool),
+	}
+
+	log.Printf("[INFO] Creating deployment for app (%s)", appID)
+	deployment, _, err := client.Apps.CreateDeployment(ctx, appID, createRequest)
+	if err != nil {
+		return diag.Errorf("Error creating deployment for app (%s): %s", appID, err)
+	}
+	deploymentID := deployment.ID
+
+	d.SetId(deploymentID)
+
+	log.Printf("[DEBUG] Waiting for app (%s) deployment (%s) to become active", appID, deploymentID)
+	if err := waitForAppDeploymentPhase(ctx, client, appID, deploymentID, d.Timeout(schema.TimeoutCreate)); err != nil {
+		return diag.FromErr(err)
+	}
+
+	return resourceAbrhaAppDeploymentRead(ctx, d, meta)
+}
+
+func resourceAbrhaAppDeploymentRead(ctx context.Context, d *schema.ResourceData, meta interface{}) diag.Diagnostics {
+	client := meta.(*config.CombinedConfig).GoApiAbrhaClient()
+	appID := d.Get("app_id").(string)
+
+	deployment, resp, err := client.Apps.GetDeployment(context.Background(), appID, d.Id())
+	if err != nil {
+		if resp != nil && resp.StatusCode == 404 {
+			log.Printf("[WARN] App (%s) deployment (%s) not found, removing from state", appID, d.Id())
+			d.SetId("")
+			return nil
+		}
+
+		return diag.Errorf("Error reading app (%s) deployment (%s): %s", appID, d.Id(), err)
+	}
+
+	d.Set("phase", string(deployment.Phase))
+	d.Set("cause", deployment.Cause)
+	d.Set("created_at", deployment.CreatedAt.UTC().String())
+	d.Set("updated_at", deployment.UpdatedAt.UTC().String())
+
+	return nil
+}
+
+func resourceAbrhaAppDeploymentDelete(ctx context.Context, d *schema.ResourceData, meta interface{}) diag.Diagnostics {
+	// Deployments cannot be deleted; removing the resource only drops it from state.
+	d.SetId("")
+	return nil
+}
+
+// waitForAppDeploymentPhase waits for a specific deployment to become active.
+// A deployment that errors is reported with its failed steps and logs.
+func waitForAppDeploymentPhase(ctx context.Context, client *goApiAbrha.Client, appID, deploymentID string, timeout time.Duration) error {
+	stateConf := &retry.StateChangeConf{
+		Pending: []string{
+			string(goApiAbrha.DeploymentPhase_Unknown),
+			string(goApiAbrha.DeploymentPhase_PendingBuild),
+			string(goApiAbrha.DeploymentPhase_Building),
+			string(goApiAbrha.DeploymentPhase_PendingDeploy),
+			string(goApiAbrha.DeploymentPhase_Deploying),
+		},
+		Target: []string{string(goApiAbrha.DeploymentPhase_Active)},
+		Refresh: func() (interface{}, string, error) {
+			deployment, _, err := client.Apps.GetDeployment(ctx, appID, deploymentID)
+			if err != nil {
+				return nil, "", fmt.Errorf("Error trying to read app deployment state: %s", err)
+			}
+
+			switch deployment.Phase {
+			case goApiAbrha.DeploymentPhase_Error:
+				return nil, "", deploymentFailureError(ctx, client, appID, deployment)
+			case goApiAbrha.DeploymentPhase_Canceled, goApiAbrha.DeploymentPhase_Superseded:
+				return nil, "", fmt.Errorf("app (%s) deployment (%s) finished with phase %s", appID, deploymentID, deployment.Phase)
+			}
+
+			log.Printf("[DEBUG] Waiting for app (%s) deployment (%s) to become active. Phase: %s", appID, deploymentID, deployment.Phase)
+			return deployment, string(deployment.Phase), nil
+		},
+		Delay:      10 * time.Second,
+		Timeout:    timeout,
+		MinTimeout: 3 * time.Second,
+	}
+
+	_, err := stateConf.WaitForStateContext(ctx)
+	return err
+}
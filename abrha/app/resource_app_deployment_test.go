@@ -0,0 +1,65 @@
+package app_test
+
+import (
+	"fmt"
+	"testing"
+
+	goApiAbrha "github.com/abrhacom/go-api-abrha"
+	"github.com/abrhacom/terraform-provider-abrha/abrha/acceptance"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/resource"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/terraform"
+)
+
+func TestAccAbrhaAppDeployment_Basic(t *testing.T) {
+	var app goApiAbrha.App
+	var deploymentID string
+	appName := acceptance.RandomTestName()
+
+	resource.ParallelTest(t, resource.TestCase{
+		PreCheck:          func() { acceptance.TestAccPreCheck(t) },
+		ProviderFactories: acceptance.TestAccProviderFactories,
+		CheckDestroy:      testAccCheckAbrhaAppDestroy,
+		Steps: []resource.TestStep{
+			{
+				Config: testAccCheckAbrhaAppDeploymentConfig_basic(appName, "1"),
+				Check: resource.ComposeTestCheckFunc(
+					testAccCheckAbrhaAppExists("abrha_app.foobar", &app),
+					resource.TestCheckResourceAttrPair("abrha_app_deployment.foobar", "app_id", "abrha_app.foobar", "id"),
+					resource.TestCheckResourceAttr("abrha_app_deployment.foobar", "phase", "ACTIVE"),
+					resource.TestCheckResourceAttr("abrha_app_deployment.foobar", "force_build", "true"),
+					resource.TestCheckResourceAttrSet("abrha_app_deployment.foobar", "cause"),
+					resource.TestCheckResourceAttrSet("abrha_app_deployment.foobar", "created_at"),
+					func(s *terraform.State) error {
+						deploymentID = s.RootModule().Resources["abrha_app_deployment.foobar"].Primary.ID
+						return nil
+					},
+				),
+			},
+			{
+				Config: testAccCheckAbrhaAppDeploymentConfig_basic(appName, "2"),
+				Check: resource.ComposeTestCheckFunc(
+					resource.TestCheckResourceAttr("abrha_app_deployment.foobar", "triggers.release", "2"),
+					resource.TestCheckResourceAttr("abrha_app_deployment.foobar", "phase", "ACTIVE"),
+					func(s *terraform.State) error {
+						if s.RootModule().Resources["abrha_app_deployment.foobar"].Primary.ID == deploymentID {
+							return fmt.Errorf("Expected a new deployment after changing triggers")
+						}
+						return nil
+					},
+				),
+			},
+		},
+	})
+}
+
+func testAccCheckAbrhaAppDeploymentConfig_basic(appName string, release string) string {
+	return fmt.Sprintf(testAccCheckAbrhaAppConfig_basic, appName) + fmt.Sprintf(`
+resource "abrha_app_deployment" "foobar" {
+  app_id      = abrha_app.foobar.id
+  force_build = true
+
+  triggers = {
+    release = "%s"
+  }
+}`, release)
+}
@@ -69,6 +69,11 @@ func TestAccAbrhaApp_Basic(t *testing.T) {
 					resource.TestCheckResourceAttrSet("abrha_app.foobar", "default_ingress"),
 					resource.TestCheckResourceAttrSet("abrha_app.foobar", "live_url"),
 					resource.TestCheckResourceAttrSet("abrha_app.foobar", "active_deployment_id"),
+					resource.TestCheckResourceAttrPair("abrha_app.foobar", "last_deployment_id",
+						"abrha_app.foobar", "active_deployment_id"),
+					resource.TestCheckResourceAttr("abrha_app.foobar", "last_deployment_phase", "ACTIVE"),
+					resource.TestCheckResourceAttrSet("abrha_app.foobar", "last_deployment_cause"),
+					resource.TestCheckResourceAttrSet("abrha_app.foobar", "projected_monthly_cost"),
 					resource.TestCheckResourceAttrSet("abrha_app.foobar", "urn"),
 					resource.TestCheckResourceAttrSet("abrha_app.foobar", "updated_at"),
 					resource.TestCheckResourceAttrSet("abrha_app.foobar", "created_at"),
@@ -167,6 +172,40 @@ func TestAccAbrhaApp_Basic(t *testing.T) {
 	})
 }
 
+func TestAccAbrhaApp_InvalidSpec(t *testing.T) {
+	appName := acceptance.RandomTestName()
+
+	resource.ParallelTest(t, resource.TestCase{
+		PreCheck:          func() { acceptance.TestAccPreCheck(t) },
+		ProviderFactories: acceptance.TestAccProviderFactories,
+		Steps: []resource.TestStep{
+			{
+				Config: fmt.Sprintf(`
+resource "abrha_app" "foobar" {
+  spec {
+    name   = "%s"
+    region = "ams"
+
+    service {
+      name               = "go-service"
+      environment_slug   = "go"
+      instance_count     = 1
+      instance_size_slug = "not-a-real-size"
+
+      git {
+        repo_clone_url = "https://github.com/abrha/sample-golang.git"
+        branch         = "main"
+      }
+    }
+  }
+}`, appName),
+				PlanOnly:    true,
+				ExpectError: regexp.MustCompile("invalid app spec"),
+			},
+		},
+	})
+}
+
 func TestAccAbrhaApp_Job(t *testing.T) {
 	var app goApiAbrha.App
 	appName := acceptance.RandomTestName()
@@ -0,0 +1,98 @@
+package billing
+
+import (
+	"context"
+	"fmt"
+	"time"
+
+	goApiAbrha "github.com/abrhacom/go-api-abrha"
+	"github.com/abrhacom/terraform-provider-abrha/abrha/config"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/schema"
+)
+
+func billingHistorySchema() map[string]*schema.Schema {
+	return map[string]*schema.Schema{
+		"description": {
+			Type:        schema.TypeString,
+			Description: "description of the billing history entry",
+		},
+		"amount": {
+			Type:        schema.TypeString,
+			Description: "amount of the billing history entry, in USD",
+		},
+		"invoice_id": {
+			Type:        schema.TypeString,
+			Description: "ID of the invoice associated with the entry, if any",
+		},
+		"invoice_uuid": {
+			Type:        schema.TypeString,
+			Description: "UUID of the invoice associated with the entry, if any",
+		},
+		"date": {
+			Type:        schema.TypeString,
+			Description: "time the billing history entry occurred",
+		},
+		"type": {
+			Type:        schema.TypeString,
+			Description: "type of the billing history entry, e.g. Invoice or Payment",
+		},
+	}
+}
+
+func getAbrhaBillingHistory(meta interface{}, extra map[string]interface{}) ([]interface{}, error) {
+	client := meta.(*config.CombinedConfig).GoApiAbrhaClient()
+
+	opts := &goApiAbrha.ListOptions{
+		Page:    1,
+		PerPage: 200,
+	}
+
+	var entryList []interface{}
+
+	for {
+		history, resp, err := client.BillingHistory.List(context.Background(), opts)
+
+		if err != nil {
+			return nil, fmt.Errorf("Error retrieving billing history: %s", err)
+		}
+
+		for _, entry := range history.BillingHistory {
+			entryList = append(entryList, entry)
+		}
+
+		if resp.Links == nil || resp.Links.IsLastPage() {
+			break
+		}
+
+		page, err := resp.Links.CurrentPage()
+		if err != nil {
+			return nil, fmt.Errorf("Error retrieving billing history: %s", err)
+		}
+
+		opts.Page = page + 1
+	}
+
+	return entryList, nil
+}
+
+func flattenAbrhaBillingHistoryEntry(rawEntry, meta interface{}, extra map[string]interface{}) (map[string]interface{}, error) {
+	entry := rawEntry.(goApiAbrha.BillingHistoryEntry)
+
+	flattenedEntry := map[string]interface{}{
+		"description":  entry.Description,
+		"amount":       entry.Amount,
+		"invoice_id":   "",
+		"invoice_uuid": "",
+		"date":         entry.Date.UTC().Format(time.RFC3339),
+		"type":         entry.Type,
+	}
+
+	if entry.InvoiceID != nil {
+		flattenedEntry["invoice_id"] = *entry.InvoiceID
+	}
+	if entry.InvoiceUUID != nil {
+		flattenedEntry["invoice_uuid"] = *entry.InvoiceUUID
+	}
+
+	return flattenedEntry, nil
+}
@@ -0,0 +1,57 @@
+package billing
+
+import (
+	"context"
+	"time"
+
+	"github.com/abrhacom/terraform-provider-abrha/abrha/config"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/diag"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/schema"
+)
+
+func DataSourceAbrhaBalance() *schema.Resource {
+	return &schema.Resource{
+		ReadContext: dataSourceAbrhaBalanceRead,
+		Schema: map[string]*schema.Schema{
+			"month_to_date_balance": {
+				Type:        schema.TypeString,
+				Computed:    true,
+				Description: "Balance as of the generated_at time, including month-to-date usage.",
+			},
+			"account_balance": {
+				Type:        schema.TypeString,
+				Computed:    true,
+				Description: "Current balance of the customer's most recent billing activity, excluding month-to-date usage.",
+			},
+			"month_to_date_usage": {
+				Type:        schema.TypeString,
+				Computed:    true,
+				Description: "Amount used in the current billing period as of the generated_at time.",
+			},
+			"generated_at": {
+				Type:        schema.TypeString,
+				Computed:    true,
+				Description: "The time at which the balance was generated.",
+			},
+		},
+	}
+}
+
+func dataSourceAbrhaBalanceRead(ctx context.Context, d *schema.ResourceData, meta interface{}) diag.Diagnostics {
+	client := meta.(*config.CombinedConfig).GoApiAbrhaClient()
+
+	balance, _, err := client.Balance.Get(context.Background())
+	if err != nil {
+		return diag.Errorf("Error retrieving balance: %s", err)
+	}
+
+	generatedAt := balance.GeneratedAt.UTC().Format(time.RFC3339)
+
+	d.SetId(generatedAt)
+	d.Set("month_to_date_balance", balance.MonthToDateBalance)
+	d.Set("account_balance", balance.AccountBalance)
+	d.Set("month_to_date_usage", balance.MonthToDateUsage)
+	d.Set("generated_at", generatedAt)
+
+	return nil
+}
@@ -0,0 +1,34 @@
+package billing_test
+
+import (
+	"testing"
+
+	"github.com/abrhacom/terraform-provider-abrha/abrha/acceptance"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/resource"
+)
+
+func TestAccDataSourceAbrhaBalance_Basic(t *testing.T) {
+	resource.ParallelTest(t, resource.TestCase{
+		PreCheck:          func() { acceptance.TestAccPreCheck(t) },
+		ProviderFactories: acceptance.TestAccProviderFactories,
+		Steps: []resource.TestStep{
+			{
+				Config: testAccCheckDataSourceAbrhaBalanceConfig_basic,
+				Check: resource.ComposeTestCheckFunc(
+					resource.TestCheckResourceAttrSet(
+						"data.abrha_balance.foobar", "month_to_date_balance"),
+					resource.TestCheckResourceAttrSet(
+						"data.abrha_balance.foobar", "account_balance"),
+					resource.TestCheckResourceAttrSet(
+						"data.abrha_balance.foobar", "month_to_date_usage"),
+					resource.TestCheckResourceAttrSet(
+						"data.abrha_balance.foobar", "generated_at"),
+				),
+			},
+		},
+	})
+}
+
+const testAccCheckDataSourceAbrhaBalanceConfig_basic = `
+data "abrha_balance" "foobar" {
+}`
@@ -0,0 +1,17 @@
+package billing
+
+import (
+	"github.com/abrhacom/terraform-provider-abrha/internal/datalist"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/schema"
+)
+
+func DataSourceAbrhaBillingHistory() *schema.Resource {
+	dataListConfig := &datalist.ResourceConfig{
+		RecordSchema:        billingHistorySchema(),
+		ResultAttributeName: "billing_history",
+		GetRecords:          getAbrhaBillingHistory,
+		FlattenRecord:       flattenAbrhaBillingHistoryEntry,
+	}
+
+	return datalist.NewResource(dataListConfig)
+}
@@ -0,0 +1,32 @@
+package billing_test
+
+import (
+	"testing"
+
+	"github.com/abrhacom/terraform-provider-abrha/abrha/acceptance"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/resource"
+)
+
+func TestAccDataSourceAbrhaBillingHistory_Basic(t *testing.T) {
+	resource.ParallelTest(t, resource.TestCase{
+		PreCheck:          func() { acceptance.TestAccPreCheck(t) },
+		ProviderFactories: acceptance.TestAccProviderFactories,
+		Steps: []resource.TestStep{
+			{
+				Config: testAccCheckDataSourceAbrhaBillingHistoryConfig_basic,
+				Check: resource.ComposeTestCheckFunc(
+					resource.TestCheckResourceAttrSet(
+						"data.abrha_billing_history.foobar", "billing_history.#"),
+				),
+			},
+		},
+	})
+}
+
+const testAccCheckDataSourceAbrhaBillingHistoryConfig_basic = `
+data "abrha_billing_history" "foobar" {
+  filter {
+    key    = "type"
+    values = ["Invoice"]
+  }
+}`
@@ -0,0 +1,17 @@
+package billing
+
+import (
+	"github.com/abrhacom/terraform-provider-abrha/internal/datalist"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/schema"
+)
+
+func DataSourceAbrhaInvoices() *schema.Resource {
+	dataListConfig := &datalist.ResourceConfig{
+		RecordSchema:        invoiceSchema(),
+		ResultAttributeName: "invoices",
+		GetRecords:          getAbrhaInvoices,
+		FlattenRecord:       flattenAbrhaInvoice,
+	}
+
+	return datalist.NewResource(dataListConfig)
+}
@@ -0,0 +1,32 @@
+package billing_test
+
+import (
+	"testing"
+
+	"github.com/abrhacom/terraform-provider-abrha/abrha/acceptance"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/resource"
+)
+
+func TestAccDataSourceAbrhaInvoices_Basic(t *testing.T) {
+	resource.ParallelTest(t, resource.TestCase{
+		PreCheck:          func() { acceptance.TestAccPreCheck(t) },
+		ProviderFactories: acceptance.TestAccProviderFactories,
+		Steps: []resource.TestStep{
+			{
+				Config: testAccCheckDataSourceAbrhaInvoicesConfig_basic,
+				Check: resource.ComposeTestCheckFunc(
+					resource.TestCheckResourceAttrSet(
+						"data.abrha_invoices.foobar", "invoices.#"),
+				),
+			},
+		},
+	})
+}
+
+const testAccCheckDataSourceAbrhaInvoicesConfig_basic = `
+data "abrha_invoices" "foobar" {
+  sort {
+    key       = "invoice_period"
+    direction = "desc"
+  }
+}`
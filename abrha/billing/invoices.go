@@ -0,0 +1,81 @@
+package billing
+
+import (
+	"context"
+	"fmt"
+	"time"
+
+	goApiAbrha "github.com/abrhacom/go-api-abrha"
+	"github.com/abrhacom/terraform-provider-abrha/abrha/config"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/schema"
+)
+
+func invoiceSchema() map[string]*schema.Schema {
+	return map[string]*schema.Schema{
+		"invoice_uuid": {
+			Type:        schema.TypeString,
+			Description: "UUID of the invoice",
+		},
+		"amount": {
+			Type:        schema.TypeString,
+			Description: "total amount of the invoice, in USD",
+		},
+		"invoice_period": {
+			Type:        schema.TypeString,
+			Description: "billing period of the invoice, in YYYY-MM format",
+		},
+		"updated_at": {
+			Type:        schema.TypeString,
+			Description: "time the invoice was last updated",
+		},
+	}
+}
+
+func getAbrhaInvoices(meta interface{}, extra map[string]interface{}) ([]interface{}, error) {
+	client := meta.(*config.CombinedConfig).GoApiAbrhaClient()
+
+	opts := &goApiAbrha.ListOptions{
+		Page:    1,
+		PerPage: 200,
+	}
+
+	var invoiceList []interface{}
+
+	for {
+		invoices, resp, err := client.Invoices.List(context.Background(), opts)
+
+		if err != nil {
+			return nil, fmt.Errorf("Error retrieving invoices: %s", err)
+		}
+
+		for _, invoice := range invoices.Invoices {
+			invoiceList = append(invoiceList, invoice)
+		}
+
+		if resp.Links == nil || resp.Links.IsLastPage() {
+			break
+		}
+
+		page, err := resp.Links.CurrentPage()
+		if err != nil {
+			return nil, fmt.Errorf("Error retrieving invoices: %s", err)
+		}
+
+		opts.Page = page + 1
+	}
+
+	return invoiceList, nil
+}
+
+func flattenAbrhaInvoice(rawInvoice, meta interface{}, extra map[string]interface{}) (map[string]interface{}, error) {
+	invoice := rawInvoice.(goApiAbrha.InvoiceListItem)
+
+	flattenedInvoice := map[string]interface{}{
+		"invoice_uuid":   invoice.InvoiceUUID,
+		"amount":         invoice.Amount,
+		"invoice_period": invoice.InvoicePeriod,
+		"updated_at":     invoice.UpdatedAt.UTC().Format(time.RFC3339),
+	}
+
+	return flattenedInvoice, nil
+}
@@ -0,0 +1,94 @@
+package cdn
+
+import (
+	"context"
+	"fmt"
+
+	goApiAbrha "github.com/abrhacom/go-api-abrha"
+	"github.com/abrhacom/terraform-provider-abrha/abrha/config"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/schema"
+)
+
+func cdnSchema() map[string]*schema.Schema {
+	return map[string]*schema.Schema{
+		"id": {
+			Type:        schema.TypeString,
+			Description: "id of the CDN endpoint",
+		},
+		"origin": {
+			Type:        schema.TypeString,
+			Description: "fully qualified domain name (FQDN) for the origin server",
+		},
+		"endpoint": {
+			Type:        schema.TypeString,
+			Description: "fully qualified domain name (FQDN) from which the CDN-backed content is served",
+		},
+		"ttl": {
+			Type:        schema.TypeInt,
+			Description: "the amount of time the content is cached in the CDN edge servers (in seconds)",
+		},
+		"certificate_id": {
+			Type:        schema.TypeString,
+			Description: "id of the certificate used for the custom domain",
+		},
+		"custom_domain": {
+			Type:        schema.TypeString,
+			Description: "fully qualified domain name (FQDN) of the custom subdomain used with the CDN endpoint",
+		},
+		"created_at": {
+			Type:        schema.TypeString,
+			Description: "the date and time (ISO8601) of when the CDN endpoint was created",
+		},
+	}
+}
+
+func getAbrhaCDNs(meta interface{}, extra map[string]interface{}) ([]interface{}, error) {
+	client := meta.(*config.CombinedConfig).GoApiAbrhaClient()
+
+	opts := &goApiAbrha.ListOptions{
+		Page:    1,
+		PerPage: 200,
+	}
+
+	var cdnList []interface{}
+
+	for {
+		cdns, resp, err := client.CDNs.List(context.Background(), opts)
+		if err != nil {
+			return nil, fmt.Errorf("Error retrieving CDN endpoints: %s", err)
+		}
+
+		for _, cdn := range cdns {
+			cdnList = append(cdnList, cdn)
+		}
+
+		if resp.Links == nil || resp.Links.IsLastPage() {
+			break
+		}
+
+		page, err := resp.Links.CurrentPage()
+		if err != nil {
+			return nil, fmt.Errorf("Error retrieving CDN endpoints: %s", err)
+		}
+
+		opts.Page = page + 1
+	}
+
+	return cdnList, nil
+}
+
+func flattenAbrhaCDN(rawCDN, meta interface{}, extra map[string]interface{}) (map[string]interface{}, error) {
+	cdn := rawCDN.(goApiAbrha.CDN)
+
+	flattenedCDN := map[string]interface{}{
+		"id":             cdn.ID,
+		"origin":         cdn.Origin,
+		"endpoint":       cdn.Endpoint,
+		"ttl":            int(cdn.TTL),
+		"certificate_id": cdn.CertificateID,
+		"custom_domain":  cdn.CustomDomain,
+		"created_at":     cdn.CreatedAt.UTC().String(),
+	}
+
+	return flattenedCDN, nil
+}
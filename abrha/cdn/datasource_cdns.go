@@ -0,0 +1,17 @@
+package cdn
+
+import (
+	"github.com/abrhacom/terraform-provider-abrha/internal/datalist"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/schema"
+)
+
+func DataSourceAbrhaCDNs() *schema.Resource {
+	dataListConfig := &datalist.ResourceConfig{
+		RecordSchema:        cdnSchema(),
+		ResultAttributeName: "cdns",
+		GetRecords:          getAbrhaCDNs,
+		FlattenRecord:       flattenAbrhaCDN,
+	}
+
+	return datalist.NewResource(dataListConfig)
+}
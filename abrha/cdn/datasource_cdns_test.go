@@ -0,0 +1,44 @@
+package cdn_test
+
+import (
+	"fmt"
+	"testing"
+
+	"github.com/abrhacom/terraform-provider-abrha/abrha/acceptance"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/resource"
+)
+
+func TestAccDataSourceAbrhaCDNs_Basic(t *testing.T) {
+	bucketName := generateBucketName()
+	resourceConfig := fmt.Sprintf(testAccCheckAbrhaCDNConfig_Create, bucketName)
+	datasourceConfig := `
+data "abrha_cdns" "result" {
+  filter {
+    key    = "origin"
+    values = [abrha_cdn.foobar.origin]
+  }
+}
+`
+
+	resource.ParallelTest(t, resource.TestCase{
+		PreCheck:          func() { acceptance.TestAccPreCheck(t) },
+		ProviderFactories: acceptance.TestAccProviderFactories,
+		Steps: []resource.TestStep{
+			{
+				Config: resourceConfig,
+			},
+			{
+				Config: resourceConfig + datasourceConfig,
+				Check: resource.ComposeTestCheckFunc(
+					resource.TestCheckResourceAttr("data.abrha_cdns.result", "cdns.#", "1"),
+					resource.TestCheckResourceAttr("data.abrha_cdns.result", "cdns.0.origin", bucketName+originSuffix),
+					resource.TestCheckResourceAttr("data.abrha_cdns.result", "cdns.0.ttl", "3600"),
+					resource.TestCheckResourceAttrPair("data.abrha_cdns.result", "cdns.0.id", "abrha_cdn.foobar", "id"),
+				),
+			},
+			{
+				Config: resourceConfig,
+			},
+		},
+	})
+}
@@ -41,6 +41,11 @@ func ResourceAbrhaCDN() *schema.Resource {
 		},
 
 		Schema: resourceAbrhaCDNv1(),
+
+		Timeouts: &schema.ResourceTimeout{
+			Read:   schema.DefaultTimeout(30 * time.Second),
+			Delete: schema.DefaultTimeout(30 * time.Second),
+		},
 	}
 }
 
@@ -196,7 +201,7 @@ func resourceAbrhaCDNCreate(ctx context.Context, d *schema.ResourceData, meta in
 func resourceAbrhaCDNRead(ctx context.Context, d *schema.ResourceData, meta interface{}) diag.Diagnostics {
 	client := meta.(*config.CombinedConfig).GoApiAbrhaClient()
 
-	cdn, resp, err := getCDNWithRetryBackoff(ctx, client, d.Id())
+	cdn, resp, err := getCDNWithRetryBackoff(ctx, client, d.Id(), d.Timeout(schema.TimeoutRead))
 	if err != nil {
 		if resp != nil && resp.StatusCode == 404 {
 			log.Printf("[DEBUG] CDN  (%s) was not found - removing from state", d.Id())
@@ -232,12 +237,11 @@ func resourceAbrhaCDNRead(ctx context.Context, d *schema.ResourceData, meta inte
 	return nil
 }
 
-func getCDNWithRetryBackoff(ctx context.Context, client *goApiAbrha.Client, id string) (*goApiAbrha.CDN, *goApiAbrha.Response, error) {
+func getCDNWithRetryBackoff(ctx context.Context, client *goApiAbrha.Client, id string, timeout time.Duration) (*goApiAbrha.CDN, *goApiAbrha.Response, error) {
 	var (
-		cdn     *goApiAbrha.CDN
-		resp    *goApiAbrha.Response
-		timeout = 30 * time.Second
-		err     error
+		cdn  *goApiAbrha.CDN
+		resp *goApiAbrha.Response
+		err  error
 	)
 	err = retry.RetryContext(ctx, timeout, func() *retry.RetryError {
 		cdn, resp, err = client.CDNs.Get(ctx, id)
@@ -317,9 +321,8 @@ func resourceAbrhaCDNDelete(ctx context.Context, d *schema.ResourceData, meta in
 	client := meta.(*config.CombinedConfig).GoApiAbrhaClient()
 	resourceID := d.Id()
 
-	timeout := 30 * time.Second
-	err := retry.RetryContext(ctx, timeout, func() *retry.RetryError {
-		_, err := client.CDNs.Delete(context.Background(), resourceID)
+	err := retry.RetryContext(ctx, d.Timeout(schema.TimeoutDelete), func() *retry.RetryError {
+		_, err := client.CDNs.Delete(ctx, resourceID)
 		if err != nil {
 			if util.IsAbrhaError(err, http.StatusTooManyRequests, "") {
 				log.Printf("[DEBUG] Received %s, backing off", err.Error())
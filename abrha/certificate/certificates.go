@@ -0,0 +1,105 @@
+package certificate
+
+import (
+	"context"
+	"fmt"
+
+	goApiAbrha "github.com/abrhacom/go-api-abrha"
+	"github.com/abrhacom/terraform-provider-abrha/abrha/config"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/schema"
+)
+
+func certificateSchema() map[string]*schema.Schema {
+	return map[string]*schema.Schema{
+		"name": {
+			Type:        schema.TypeString,
+			Description: "name of the certificate",
+		},
+		"uuid": {
+			Type:        schema.TypeString,
+			Description: "uuid of the certificate",
+		},
+		"type": {
+			Type:        schema.TypeString,
+			Description: "type of the certificate",
+		},
+		"state": {
+			Type:        schema.TypeString,
+			Description: "current state of the certificate",
+		},
+		"domains": {
+			Type:        schema.TypeSet,
+			Elem:        &schema.Schema{Type: schema.TypeString},
+			Description: "domains for which the certificate was issued",
+		},
+		"not_after": {
+			Type:        schema.TypeString,
+			Description: "expiration date and time of the certificate",
+		},
+		"sha1_fingerprint": {
+			Type:        schema.TypeString,
+			Description: "SHA1 fingerprint of the certificate",
+		},
+		"created_at": {
+			Type:        schema.TypeString,
+			Description: "the creation date of the certificate",
+		},
+	}
+}
+
+func getAbrhaCertificates(meta interface{}, extra map[string]interface{}) ([]interface{}, error) {
+	client := meta.(*config.CombinedConfig).GoApiAbrhaClient()
+
+	opts := &goApiAbrha.ListOptions{
+		Page:    1,
+		PerPage: 200,
+	}
+
+	var certList []interface{}
+
+	for {
+		certs, resp, err := client.Certificates.List(context.Background(), opts)
+		if err != nil {
+			return nil, fmt.Errorf("Error retrieving certificates: %s", err)
+		}
+
+		for _, cert := range certs {
+			certList = append(certList, cert)
+		}
+
+		if resp.Links == nil || resp.Links.IsLastPage() {
+			break
+		}
+
+		page, err := resp.Links.CurrentPage()
+		if err != nil {
+			return nil, fmt.Errorf("Error retrieving certificates: %s", err)
+		}
+
+		opts.Page = page + 1
+	}
+
+	return certList, nil
+}
+
+func flattenAbrhaCertificate(rawCertificate, meta interface{}, extra map[string]interface{}) (map[string]interface{}, error) {
+	cert := rawCertificate.(goApiAbrha.Certificate)
+
+	domains := flattenAbrhaCertificateDomains(cert.DNSNames)
+	if domains == nil {
+		domains = schema.NewSet(schema.HashString, []interface{}{})
+	}
+
+	flattenedCertificate := map[string]interface{}{
+		"name":             cert.Name,
+		"uuid":             cert.ID,
+		"type":             cert.Type,
+		"state":            cert.State,
+		"domains":          domains,
+		"not_after":        cert.NotAfter,
+		"sha1_fingerprint": cert.SHA1Fingerprint,
+		"created_at":       cert.Created,
+	}
+
+	return flattenedCertificate, nil
+}
@@ -0,0 +1,17 @@
+package certificate
+
+import (
+	"github.com/abrhacom/terraform-provider-abrha/internal/datalist"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/schema"
+)
+
+func DataSourceAbrhaCertificates() *schema.Resource {
+	dataListConfig := &datalist.ResourceConfig{
+		RecordSchema:        certificateSchema(),
+		ResultAttributeName: "certificates",
+		GetRecords:          getAbrhaCertificates,
+		FlattenRecord:       flattenAbrhaCertificate,
+	}
+
+	return datalist.NewResource(dataListConfig)
+}
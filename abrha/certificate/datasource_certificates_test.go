@@ -0,0 +1,46 @@
+package certificate_test
+
+import (
+	"fmt"
+	"testing"
+
+	"github.com/abrhacom/terraform-provider-abrha/abrha/acceptance"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/resource"
+)
+
+func TestAccDataSourceAbrhaCertificates_Basic(t *testing.T) {
+	name := acceptance.RandomTestName("certificate")
+
+	privateKeyMaterial, leafCertMaterial, certChainMaterial := acceptance.GenerateTestCertMaterial(t)
+	resourceConfig := testAccCheckDataSourceAbrhaCertificateConfig_basic(name, privateKeyMaterial, leafCertMaterial, certChainMaterial, false)
+	datasourceConfig := fmt.Sprintf(`
+data "abrha_certificates" "result" {
+  filter {
+    key    = "name"
+    values = ["%s"]
+  }
+}
+`, name)
+
+	resource.ParallelTest(t, resource.TestCase{
+		PreCheck:          func() { acceptance.TestAccPreCheck(t) },
+		ProviderFactories: acceptance.TestAccProviderFactories,
+		Steps: []resource.TestStep{
+			{
+				Config: resourceConfig,
+			},
+			{
+				Config: resourceConfig + datasourceConfig,
+				Check: resource.ComposeTestCheckFunc(
+					resource.TestCheckResourceAttr("data.abrha_certificates.result", "certificates.#", "1"),
+					resource.TestCheckResourceAttr("data.abrha_certificates.result", "certificates.0.name", name),
+					resource.TestCheckResourceAttr("data.abrha_certificates.result", "certificates.0.type", "custom"),
+					resource.TestCheckResourceAttrPair("data.abrha_certificates.result", "certificates.0.uuid", "abrha_certificate.foo", "uuid"),
+				),
+			},
+			{
+				Config: resourceConfig,
+			},
+		},
+	})
+}
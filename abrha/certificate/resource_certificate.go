@@ -36,6 +36,11 @@ func ResourceAbrhaCertificate() *schema.Resource {
 				Version: 0,
 			},
 		},
+
+		Timeouts: &schema.ResourceTimeout{
+			Create: schema.DefaultTimeout(20 * time.Minute),
+			Delete: schema.DefaultTimeout(30 * time.Second),
+		},
 	}
 }
 
@@ -293,9 +298,8 @@ func resourceAbrhaCertificateDelete(ctx context.Context, d *schema.ResourceData,
 		return nil
 	}
 
-	timeout := 30 * time.Second
-	err = retry.RetryContext(ctx, timeout, func() *retry.RetryError {
-		_, err = client.Certificates.Delete(context.Background(), cert.ID)
+	err = retry.RetryContext(ctx, d.Timeout(schema.TimeoutDelete), func() *retry.RetryError {
+		_, err = client.Certificates.Delete(ctx, cert.ID)
 		if err != nil {
 			if util.IsAbrhaError(err, http.StatusForbidden, "Make sure the certificate is not in use before deleting it") {
 				log.Printf("[DEBUG] Received %s, retrying certificate deletion", err.Error())
@@ -0,0 +1,75 @@
+package database
+
+import (
+	"context"
+	"fmt"
+	"time"
+
+	goApiAbrha "github.com/abrhacom/go-api-abrha"
+	"github.com/abrhacom/terraform-provider-abrha/abrha/config"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/schema"
+)
+
+func databaseBackupSchema() map[string]*schema.Schema {
+	return map[string]*schema.Schema{
+		"created_at": {
+			Type:        schema.TypeString,
+			Description: "the creation date of the backup, usable as backup_created_at when restoring",
+		},
+		"size_gigabytes": {
+			Type:        schema.TypeFloat,
+			Description: "the size of the backup in gigabytes",
+		},
+	}
+}
+
+func getAbrhaDatabaseBackups(meta interface{}, extra map[string]interface{}) ([]interface{}, error) {
+	client := meta.(*config.CombinedConfig).GoApiAbrhaClient()
+
+	clusterID, ok := extra["cluster_id"].(string)
+	if !ok {
+		return nil, fmt.Errorf("unable to find `cluster_id` key from query data")
+	}
+
+	opts := &goApiAbrha.ListOptions{
+		Page:    1,
+		PerPage: 200,
+	}
+
+	var backupList []interface{}
+
+	for {
+		backups, resp, err := client.Databases.ListBackups(context.Background(), clusterID, opts)
+		if err != nil {
+			return nil, fmt.Errorf("Error retrieving database backups: %s", err)
+		}
+
+		for _, backup := range backups {
+			backupList = append(backupList, backup)
+		}
+
+		if resp.Links == nil || resp.Links.IsLastPage() {
+			break
+		}
+
+		page, err := resp.Links.CurrentPage()
+		if err != nil {
+			return nil, fmt.Errorf("Error retrieving database backups: %s", err)
+		}
+
+		opts.Page = page + 1
+	}
+
+	return backupList, nil
+}
+
+func flattenAbrhaDatabaseBackup(rawBackup, meta interface{}, extra map[string]interface{}) (map[string]interface{}, error) {
+	backup := rawBackup.(goApiAbrha.DatabaseBackup)
+
+	flattenedBackup := map[string]interface{}{
+		"created_at":     backup.CreatedAt.UTC().Format(time.RFC3339),
+		"size_gigabytes": backup.SizeGigabytes,
+	}
+
+	return flattenedBackup, nil
+}
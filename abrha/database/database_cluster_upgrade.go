@@ -0,0 +1,212 @@
+package database
+
+import (
+	"context"
+	"fmt"
+	"log"
+	"sort"
+	"time"
+
+	goApiAbrha "github.com/abrhacom/go-api-abrha"
+	"github.com/abrhacom/terraform-provider-abrha/abrha/config"
+	"github.com/hashicorp/go-version"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/retry"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/schema"
+)
+
+// preUpgradeBackupMaxAge is how recent the latest backup of a cluster must be
+// for a major version upgrade to proceed when require_pre_upgrade_backup is set.
+const preUpgradeBackupMaxAge = 24 * time.Hour
+
+// validateSafeVersionUpgrade rejects downgrades and upgrades that skip a major
+// version still offered for the engine when safe_version_upgrade is enabled.
+func validateSafeVersionUpgrade() schema.CustomizeDiffFunc {
+	return schema.CustomizeDiffFunc(func(ctx context.Context, diff *schema.ResourceDiff, v interface{}) error {
+		if diff.Id() == "" || !diff.Get("safe_version_upgrade").(bool) || !diff.HasChange("version") {
+			return nil
+		}
+
+		oldRaw, newRaw := diff.GetChange("version")
+		if oldRaw.(string) == "" || !diff.NewValueKnown("version") {
+			return nil
+		}
+
+		client := v.(*config.CombinedConfig).GoApiAbrhaClient()
+		options, _, err := client.Databases.ListOptions(ctx)
+		if err != nil {
+			return fmt.Errorf("Error retrieving database options: %s", err)
+		}
+
+		engine := diff.Get("engine").(string)
+		engineOptions, ok := databaseEngineOptions(options, engine)
+		if !ok {
+			return fmt.Errorf("no version information available for the %s engine", engine)
+		}
+
+		return checkMajorVersionUpgrade(engine, oldRaw.(string), newRaw.(string), engineOptions.Versions)
+	})
+}
+
+// planPreUpgradeBackup marks pre_upgrade_backup_created_at as changing when a
+// major version upgrade that requires a recent backup is planned.
+func planPreUpgradeBackup() schema.CustomizeDiffFunc {
+	return schema.CustomizeDiffFunc(func(ctx context.Context, diff *schema.ResourceDiff, v interface{}) error {
+		if diff.Id() == "" || !diff.HasChange("version") {
+			return nil
+		}
+
+		if !diff.Get("safe_version_upgrade").(bool) || !diff.Get("require_pre_upgrade_backup").(bool) {
+			return nil
+		}
+
+		return diff.SetNewComputed("pre_upgrade_backup_created_at")
+	})
+}
+
+func databaseEngineOptions(options *goApiAbrha.DatabaseOptions, engine string) (goApiAbrha.DatabaseEngineOptions, bool) {
+	switch engine {
+	case "pg":
+		return options.PostgresSQLOptions, true
+	case mysqlDBEngineSlug:
+		return options.MySQLOptions, true
+	case redisDBEngineSlug:
+		return options.RedisOptions, true
+	case "mongodb":
+		return options.MongoDBOptions, true
+	case kafkaDBEngineSlug:
+		return options.KafkaOptions, true
+	case "opensearch":
+		return options.OpensearchOptions, true
+	}
+
+	return goApiAbrha.DatabaseEngineOptions{}, false
+}
+
+// checkMajorVersionUpgrade verifies that newVersion is an available version
+// newer than oldVersion and that no available version lies between them.
+func checkMajorVersionUpgrade(engine, oldVersion, newVersion string, available []string) error {
+	oldVer, err := version.NewVersion(oldVersion)
+	if err != nil {
+		return fmt.Errorf("unable to parse current %s version %q: %s", engine, oldVersion, err)
+	}
+
+	newVer, err := version.NewVersion(newVersion)
+	if err != nil {
+		return fmt.Errorf("unable to parse %s version %q: %s", engine, newVersion, err)
+	}
+
+	if newVer.LessThan(oldVer) {
+		return fmt.Errorf("downgrading %s from version %s to %s is not supported", engine, oldVersion, newVersion)
+	}
+
+	var (
+		found   bool
+		skipped []*version.Version
+	)
+	for _, raw := range available {
+		v, err := version.NewVersion(raw)
+		if err != nil {
+			continue
+		}
+
+		if v.Equal(newVer) {
+			found = true
+		}
+
+		if v.GreaterThan(oldVer) && v.LessThan(newVer) {
+			skipped = append(skipped, v)
+		}
+	}
+
+	if !found {
+		return fmt.Errorf("%s version %s is not available, available versions are: %v", engine, newVersion, available)
+	}
+
+	if len(skipped) > 0 {
+		sort.Sort(version.Collection(skipped))
+		return fmt.Errorf("upgrading %s from version %s to %s skips version %s, upgrade one major version at a time",
+			engine, oldVersion, newVersion, skipped[0].Original())
+	}
+
+	return nil
+}
+
+// latestDatabaseBackup returns the most recent backup of a cluster, or nil if
+// the cluster has no backups.
+func latestDatabaseBackup(ctx context.Context, client *goApiAbrha.Client, clusterID string) (*goApiAbrha.DatabaseBackup, error) {
+	backups, _, err := client.Databases.ListBackups(ctx, clusterID, nil)
+	if err != nil {
+		return nil, err
+	}
+
+	var latest *goApiAbrha.DatabaseBackup
+	for i := range backups {
+		if latest == nil || backups[i].CreatedAt.After(latest.CreatedAt) {
+			latest = &backups[i]
+		}
+	}
+
+	return latest, nil
+}
+
+// upgradeDatabaseClusterVersion performs a major version upgrade. With
+// safe_version_upgrade enabled, it first verifies a recent backup exists when
+// required and afterwards waits until the cluster is online at the new version.
+func upgradeDatabaseClusterVersion(ctx context.Context, client *goApiAbrha.Client, d *schema.ResourceData) error {
+	targetVersion := d.Get("version").(string)
+	safeUpgrade := d.Get("safe_version_upgrade").(bool)
+
+	if safeUpgrade && d.Get("require_pre_upgrade_backup").(bool) {
+		// The API does not offer on-demand backups, so the upgrade relies on
+		// the most recent scheduled backup instead.
+		backup, err := latestDatabaseBackup(ctx, client, d.Id())
+		if err != nil {
+			return fmt.Errorf("Error retrieving backups for database cluster: %s", err)
+		}
+
+		if backup == nil || time.Since(backup.CreatedAt) > preUpgradeBackupMaxAge {
+			return fmt.Errorf("database cluster has no backup from the last %s, refusing to upgrade to version %s", preUpgradeBackupMaxAge, targetVersion)
+		}
+
+		log.Printf("[INFO] Database cluster (%s) latest backup before upgrade: %s", d.Id(), backup.CreatedAt.UTC().Format(time.RFC3339))
+		d.Set("pre_upgrade_backup_created_at", backup.CreatedAt.UTC().Format(time.RFC3339))
+	}
+
+	_, err := client.Databases.UpgradeMajorVersion(ctx, d.Id(), &goApiAbrha.UpgradeVersionRequest{Version: targetVersion})
+	if err != nil {
+		return err
+	}
+
+	if safeUpgrade {
+		return waitForDatabaseClusterVersion(ctx, client, d.Id(), targetVersion, d.Timeout(schema.TimeoutUpdate))
+	}
+
+	return nil
+}
+
+func waitForDatabaseClusterVersion(ctx context.Context, client *goApiAbrha.Client, clusterID, targetVersion string, timeout time.Duration) error {
+	stateConf := &retry.StateChangeConf{
+		Pending: []string{"upgrading"},
+		Target:  []string{"upgraded"},
+		Refresh: func() (interface{}, string, error) {
+			database, _, err := client.Databases.Get(ctx, clusterID)
+			if err != nil {
+				return nil, "", fmt.Errorf("Error trying to read database cluster state: %s", err)
+			}
+
+			if database.Status == "online" && database.VersionSlug == targetVersion {
+				return database, "upgraded", nil
+			}
+
+			log.Printf("[DEBUG] Waiting for database cluster (%s) upgrade to version %s. Status: %s, version: %s",
+				clusterID, targetVersion, database.Status, database.VersionSlug)
+			return database, "upgrading", nil
+		},
+		Delay:      15 * time.Second,
+		Timeout:    timeout,
+		MinTimeout: 15 * time.Second,
+	}
+
+	_, err := stateConf.WaitForStateContext(ctx)
+	return err
+}
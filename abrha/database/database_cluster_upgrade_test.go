@@ -0,0 +1,43 @@
+package database
+
+import (
+	"strings"
+	"testing"
+)
+
+func TestCheckMajorVersionUpgrade(t *testing.T) {
+	t.Parallel()
+
+	available := []string{"13", "14", "15", "16"}
+
+	tt := []struct {
+		name       string
+		oldVersion string
+		newVersion string
+		err        string
+	}{
+		{name: "next major version", oldVersion: "14", newVersion: "15"},
+		{name: "same version", oldVersion: "15", newVersion: "15"},
+		{name: "downgrade", oldVersion: "15", newVersion: "14", err: "downgrading pg from version 15 to 14 is not supported"},
+		{name: "skipped version", oldVersion: "13", newVersion: "16", err: "skips version 14"},
+		{name: "unavailable version", oldVersion: "16", newVersion: "17", err: "pg version 17 is not available"},
+		{name: "invalid current version", oldVersion: "latest", newVersion: "15", err: "unable to parse current pg version"},
+		{name: "invalid new version", oldVersion: "14", newVersion: "next", err: "unable to parse pg version"},
+	}
+
+	for _, tc := range tt {
+		t.Run(tc.name, func(t *testing.T) {
+			err := checkMajorVersionUpgrade("pg", tc.oldVersion, tc.newVersion, available)
+			if tc.err == "" {
+				if err != nil {
+					t.Fatalf("unexpected error: %s", err)
+				}
+				return
+			}
+
+			if err == nil || !strings.Contains(err.Error(), tc.err) {
+				t.Fatalf("expected error containing %q, got: %v", tc.err, err)
+			}
+		})
+	}
+}
@@ -0,0 +1,157 @@
+package database
+
+import (
+	"context"
+	"fmt"
+	"strconv"
+	"time"
+
+	goApiAbrha "github.com/abrhacom/go-api-abrha"
+	"github.com/abrhacom/terraform-provider-abrha/abrha/config"
+	"github.com/abrhacom/terraform-provider-abrha/abrha/tag"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/schema"
+)
+
+func databaseClusterSchema() map[string]*schema.Schema {
+	return map[string]*schema.Schema{
+		"id": {
+			Type:        schema.TypeString,
+			Description: "id of the database cluster",
+		},
+		"name": {
+			Type:        schema.TypeString,
+			Description: "name of the database cluster",
+		},
+		"urn": {
+			Type:        schema.TypeString,
+			Description: "the uniform resource name for the database cluster",
+		},
+		"engine": {
+			Type:        schema.TypeString,
+			Description: "the database engine of the cluster",
+		},
+		"version": {
+			Type:        schema.TypeString,
+			Description: "the version of the database engine",
+		},
+		"size": {
+			Type:        schema.TypeString,
+			Description: "the size of the nodes in the database cluster",
+		},
+		"region": {
+			Type:        schema.TypeString,
+			Description: "the region that the database cluster is deployed in",
+		},
+		"node_count": {
+			Type:        schema.TypeInt,
+			Description: "the number of nodes in the database cluster",
+		},
+		"status": {
+			Type:        schema.TypeString,
+			Description: "the status of the database cluster",
+		},
+		"host": {
+			Type:        schema.TypeString,
+			Description: "the public hostname of the database cluster",
+		},
+		"private_host": {
+			Type:        schema.TypeString,
+			Description: "the private hostname of the database cluster",
+		},
+		"port": {
+			Type:        schema.TypeInt,
+			Description: "the port of the database cluster",
+		},
+		"database_names": {
+			Type:        schema.TypeList,
+			Elem:        &schema.Schema{Type: schema.TypeString},
+			Description: "the names of the databases in the cluster",
+		},
+		"private_network_uuid": {
+			Type:        schema.TypeString,
+			Description: "UUID of the VPC in which the database cluster is located",
+		},
+		"project_id": {
+			Type:        schema.TypeString,
+			Description: "the id of the project that the database cluster is assigned to",
+		},
+		"storage_size_mib": {
+			Type:        schema.TypeString,
+			Description: "the amount of storage of the database cluster in MiB",
+		},
+		"created_at": {
+			Type:        schema.TypeString,
+			Description: "the creation date of the database cluster",
+		},
+		"tags": tag.TagsDataSourceSchema(),
+	}
+}
+
+func getAbrhaDatabaseClusters(meta interface{}, extra map[string]interface{}) ([]interface{}, error) {
+	client := meta.(*config.CombinedConfig).GoApiAbrhaClient()
+
+	opts := &goApiAbrha.ListOptions{
+		Page:    1,
+		PerPage: 200,
+	}
+
+	var clusterList []interface{}
+
+	for {
+		clusters, resp, err := client.Databases.List(context.Background(), opts)
+		if err != nil {
+			return nil, fmt.Errorf("Error retrieving database clusters: %s", err)
+		}
+
+		for _, cluster := range clusters {
+			clusterList = append(clusterList, cluster)
+		}
+
+		if resp.Links == nil || resp.Links.IsLastPage() {
+			break
+		}
+
+		page, err := resp.Links.CurrentPage()
+		if err != nil {
+			return nil, fmt.Errorf("Error retrieving database clusters: %s", err)
+		}
+
+		opts.Page = page + 1
+	}
+
+	return clusterList, nil
+}
+
+func flattenAbrhaDatabaseCluster(rawCluster, meta interface{}, extra map[string]interface{}) (map[string]interface{}, error) {
+	cluster := rawCluster.(goApiAbrha.Database)
+
+	flattenedCluster := map[string]interface{}{
+		"id":                   cluster.ID,
+		"name":                 cluster.Name,
+		"urn":                  cluster.URN(),
+		"engine":               cluster.EngineSlug,
+		"version":              cluster.VersionSlug,
+		"size":                 cluster.SizeSlug,
+		"region":               cluster.RegionSlug,
+		"node_count":           cluster.NumNodes,
+		"status":               cluster.Status,
+		"database_names":       cluster.DBNames,
+		"private_network_uuid": cluster.PrivateNetworkUUID,
+		"project_id":           cluster.ProjectID,
+		"storage_size_mib":     strconv.FormatUint(cluster.StorageSizeMib, 10),
+		"created_at":           cluster.CreatedAt.UTC().Format(time.RFC3339),
+	}
+
+	if cluster.Connection != nil {
+		flattenedCluster["host"] = cluster.Connection.Host
+		flattenedCluster["port"] = cluster.Connection.Port
+	}
+
+	if cluster.PrivateConnection != nil {
+		flattenedCluster["private_host"] = cluster.PrivateConnection.Host
+	}
+
+	flattenedCluster["tags"] = tag.FlattenTags(cluster.Tags)
+
+	return flattenedCluster, nil
+}
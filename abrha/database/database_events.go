@@ -0,0 +1,84 @@
+package database
+
+import (
+	"context"
+	"fmt"
+
+	goApiAbrha "github.com/abrhacom/go-api-abrha"
+	"github.com/abrhacom/terraform-provider-abrha/abrha/config"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/schema"
+)
+
+func databaseEventSchema() map[string]*schema.Schema {
+	return map[string]*schema.Schema{
+		"id": {
+			Type:        schema.TypeString,
+			Description: "id of the event",
+		},
+		"cluster_name": {
+			Type:        schema.TypeString,
+			Description: "name of the database cluster the event belongs to",
+		},
+		"event_type": {
+			Type:        schema.TypeString,
+			Description: "the type of the event",
+		},
+		"created_at": {
+			Type:        schema.TypeString,
+			Description: "the creation date of the event",
+		},
+	}
+}
+
+func getAbrhaDatabaseEvents(meta interface{}, extra map[string]interface{}) ([]interface{}, error) {
+	client := meta.(*config.CombinedConfig).GoApiAbrhaClient()
+
+	clusterID, ok := extra["cluster_id"].(string)
+	if !ok {
+		return nil, fmt.Errorf("unable to find `cluster_id` key from query data")
+	}
+
+	opts := &goApiAbrha.ListOptions{
+		Page:    1,
+		PerPage: 200,
+	}
+
+	var eventList []interface{}
+
+	for {
+		events, resp, err := client.Databases.ListDatabaseEvents(context.Background(), clusterID, opts)
+		if err != nil {
+			return nil, fmt.Errorf("Error retrieving database events: %s", err)
+		}
+
+		for _, event := range events {
+			eventList = append(eventList, event)
+		}
+
+		if resp.Links == nil || resp.Links.IsLastPage() {
+			break
+		}
+
+		page, err := resp.Links.CurrentPage()
+		if err != nil {
+			return nil, fmt.Errorf("Error retrieving database events: %s", err)
+		}
+
+		opts.Page = page + 1
+	}
+
+	return eventList, nil
+}
+
+func flattenAbrhaDatabaseEvent(rawEvent, meta interface{}, extra map[string]interface{}) (map[string]interface{}, error) {
+	event := rawEvent.(goApiAbrha.DatabaseEvent)
+
+	flattenedEvent := map[string]interface{}{
+		"id":           event.ID,
+		"cluster_name": event.ServiceName,
+		"event_type":   event.EventType,
+		"created_at":   event.CreateTime,
+	}
+
+	return flattenedEvent, nil
+}
@@ -0,0 +1,95 @@
+package database
+
+import (
+	"reflect"
+	"testing"
+	"time"
+
+	goApiAbrha "github.com/abrhacom/go-api-abrha"
+)
+
+func TestFilterExpiredIndexes(t *testing.T) {
+	t.Parallel()
+
+	cutoff := time.Date(2024, 3, 10, 12, 0, 0, 0, time.UTC)
+	index := func(name, createTime string) goApiAbrha.DatabaseIndex {
+		return goApiAbrha.DatabaseIndex{IndexName: name, CreateTime: createTime}
+	}
+
+	tt := []struct {
+		name     string
+		indexes  []goApiAbrha.DatabaseIndex
+		pattern  string
+		expected []string
+	}{
+		{
+			name:     "no indexes",
+			indexes:  nil,
+			pattern:  "logs-*",
+			expected: []string{},
+		},
+		{
+			name: "expired and recent indexes",
+			indexes: []goApiAbrha.DatabaseIndex{
+				index("logs-2024.03.01", "2024-03-01T00:00:00Z"),
+				index("logs-2024.02.01", "2024-02-01T00:00:00Z"),
+				index("logs-2024.03.11", "2024-03-11T00:00:00Z"),
+			},
+			pattern:  "logs-*",
+			expected: []string{"logs-2024.02.01", "logs-2024.03.01"},
+		},
+		{
+			name: "non-matching names",
+			indexes: []goApiAbrha.DatabaseIndex{
+				index("metrics-2024.02.01", "2024-02-01T00:00:00Z"),
+				index("logs", "2024-02-01T00:00:00Z"),
+			},
+			pattern:  "logs-*",
+			expected: []string{},
+		},
+		{
+			name: "hidden indexes with a normal pattern",
+			indexes: []goApiAbrha.DatabaseIndex{
+				index(".kibana_1", "2024-02-01T00:00:00Z"),
+				index("logs-2024.02.01", "2024-02-01T00:00:00Z"),
+			},
+			pattern:  "*",
+			expected: []string{"logs-2024.02.01"},
+		},
+		{
+			name: "hidden indexes with a dot-prefixed pattern",
+			indexes: []goApiAbrha.DatabaseIndex{
+				index(".ds-logs-2024.02.01", "2024-02-01T00:00:00Z"),
+				index("logs-2024.02.01", "2024-02-01T00:00:00Z"),
+			},
+			pattern:  ".ds-*",
+			expected: []string{".ds-logs-2024.02.01"},
+		},
+		{
+			name: "invalid creation time",
+			indexes: []goApiAbrha.DatabaseIndex{
+				index("logs-unknown", "last week"),
+				index("logs-empty", ""),
+			},
+			pattern:  "logs-*",
+			expected: []string{},
+		},
+		{
+			name: "created exactly at cutoff",
+			indexes: []goApiAbrha.DatabaseIndex{
+				index("logs-at-cutoff", "2024-03-10T12:00:00Z"),
+				index("logs-before-cutoff", "2024-03-10T11:59:59Z"),
+			},
+			pattern:  "logs-*",
+			expected: []string{"logs-before-cutoff"},
+		},
+	}
+
+	for _, tc := range tt {
+		t.Run(tc.name, func(t *testing.T) {
+			if got := filterExpiredIndexes(tc.indexes, tc.pattern, cutoff); !reflect.DeepEqual(got, tc.expected) {
+				t.Errorf("filterExpiredIndexes(%q) = %v, expected %v", tc.pattern, got, tc.expected)
+			}
+		})
+	}
+}
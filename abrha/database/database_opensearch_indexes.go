@@ -0,0 +1,116 @@
+package database
+
+import (
+	"context"
+	"fmt"
+
+	goApiAbrha "github.com/abrhacom/go-api-abrha"
+	"github.com/abrhacom/terraform-provider-abrha/abrha/config"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/schema"
+)
+
+func databaseOpensearchIndexSchema() map[string]*schema.Schema {
+	return map[string]*schema.Schema{
+		"index_name": {
+			Type:        schema.TypeString,
+			Description: "name of the index",
+		},
+		"number_of_shards": {
+			Type:        schema.TypeInt,
+			Description: "the number of shards of the index",
+		},
+		"number_of_replicas": {
+			Type:        schema.TypeInt,
+			Description: "the number of replicas of the index",
+		},
+		"size": {
+			Type:        schema.TypeInt,
+			Description: "the size of the index in bytes",
+		},
+		"health": {
+			Type:        schema.TypeString,
+			Description: "the health of the index",
+		},
+		"status": {
+			Type:        schema.TypeString,
+			Description: "the status of the index",
+		},
+		"docs": {
+			Type:        schema.TypeInt,
+			Description: "the number of documents in the index",
+		},
+		"created_at": {
+			Type:        schema.TypeString,
+			Description: "the creation date of the index",
+		},
+	}
+}
+
+func listDatabaseIndexes(ctx context.Context, client *goApiAbrha.Client, clusterID string) ([]goApiAbrha.DatabaseIndex, *goApiAbrha.Response, error) {
+	opts := &goApiAbrha.ListOptions{
+		Page:    1,
+		PerPage: 200,
+	}
+
+	var indexList []goApiAbrha.DatabaseIndex
+
+	for {
+		indexes, resp, err := client.Databases.ListIndexes(ctx, clusterID, opts)
+		if err != nil {
+			return nil, resp, fmt.Errorf("Error retrieving database indexes: %s", err)
+		}
+
+		indexList = append(indexList, indexes...)
+
+		if resp.Links == nil || resp.Links.IsLastPage() {
+			break
+		}
+
+		page, err := resp.Links.CurrentPage()
+		if err != nil {
+			return nil, resp, fmt.Errorf("Error retrieving database indexes: %s", err)
+		}
+
+		opts.Page = page + 1
+	}
+
+	return indexList, nil, nil
+}
+
+func getAbrhaDatabaseOpensearchIndexes(meta interface{}, extra map[string]interface{}) ([]interface{}, error) {
+	client := meta.(*config.CombinedConfig).GoApiAbrhaClient()
+
+	clusterID, ok := extra["cluster_id"].(string)
+	if !ok {
+		return nil, fmt.Errorf("unable to find `cluster_id` key from query data")
+	}
+
+	indexes, _, err := listDatabaseIndexes(context.Background(), client, clusterID)
+	if err != nil {
+		return nil, err
+	}
+
+	var indexList []interface{}
+	for _, index := range indexes {
+		indexList = append(indexList, index)
+	}
+
+	return indexList, nil
+}
+
+func flattenAbrhaDatabaseOpensearchIndex(rawIndex, meta interface{}, extra map[string]interface{}) (map[string]interface{}, error) {
+	index := rawIndex.(goApiAbrha.DatabaseIndex)
+
+	flattenedIndex := map[string]interface{}{
+		"index_name":         index.IndexName,
+		"number_of_shards":   int(index.NumberofShards),
+		"number_of_replicas": int(index.NumberofReplicas),
+		"size":               int(index.Size),
+		"health":             index.Health,
+		"status":             index.Status,
+		"docs":               int(index.Docs),
+		"created_at":         index.CreateTime,
+	}
+
+	return flattenedIndex, nil
+}
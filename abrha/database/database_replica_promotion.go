@@ -0,0 +1,96 @@
+package database
+
+import (
+	"context"
+	"fmt"
+	"log"
+	"strconv"
+	"time"
+
+	goApiAbrha "github.com/abrhacom/go-api-abrha"
+	"github.com/abrhacom/terraform-provider-abrha/abrha/tag"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/retry"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/schema"
+)
+
+// validateDatabaseReplicaPromotion rejects unsetting promote, as a promoted
+// replica cannot be turned back into a replica.
+func validateDatabaseReplicaPromotion() schema.CustomizeDiffFunc {
+	return schema.CustomizeDiffFunc(func(ctx context.Context, diff *schema.ResourceDiff, v interface{}) error {
+		if diff.Id() == "" {
+			return nil
+		}
+
+		oldRaw, newRaw := diff.GetChange("promote")
+		if oldRaw.(bool) && !newRaw.(bool) {
+			return fmt.Errorf("DatabaseReplica (%s) has been promoted to a standalone database cluster and cannot be demoted, set promote to true", diff.Get("name").(string))
+		}
+
+		return nil
+	})
+}
+
+// promoteDatabaseReplica promotes a replica to a standalone cluster, which
+// keeps the replica's ID, and waits for the cluster to be online.
+func promoteDatabaseReplica(ctx context.Context, client *goApiAbrha.Client, d *schema.ResourceData, timeout time.Duration) error {
+	clusterID := d.Get("cluster_id").(string)
+	name := d.Get("name").(string)
+	deadline := time.Now().Add(timeout)
+
+	log.Printf("[INFO] Promoting DatabaseReplica (%s) of cluster (%s) to primary", name, clusterID)
+	if _, err := client.Databases.PromoteReplicaToPrimary(ctx, clusterID, name); err != nil {
+		return fmt.Errorf("Error promoting DatabaseReplica: %s", err)
+	}
+
+	if err := waitForDatabaseReplicaDetached(ctx, client, clusterID, name, time.Until(deadline)); err != nil {
+		return fmt.Errorf("Error waiting for DatabaseReplica to be promoted: %s", err)
+	}
+
+	if err := waitForDatabaseClusterOnline(ctx, client, d.Get("uuid").(string), time.Until(deadline)); err != nil {
+		return fmt.Errorf("Error waiting for promoted database cluster to become online: %s", err)
+	}
+
+	return nil
+}
+
+// setPromotedDatabaseReplicaAttributes sets the attributes of a replica from
+// the standalone cluster it was promoted to.
+func setPromotedDatabaseReplicaAttributes(database *goApiAbrha.Database, d *schema.ResourceData) error {
+	d.Set("promote", true)
+	d.Set("size", database.SizeSlug)
+	d.Set("region", database.RegionSlug)
+	d.Set("tags", tag.FlattenTags(database.Tags))
+	d.Set("uuid", database.ID)
+	d.Set("private_network_uuid", database.PrivateNetworkUUID)
+	d.Set("storage_size_mib", strconv.FormatUint(database.StorageSizeMib, 10))
+
+	return setDatabaseConnectionInfo(database, d)
+}
+
+// waitForDatabaseReplicaDetached waits until a promoted replica is no longer
+// listed as a replica of its former primary cluster.
+func waitForDatabaseReplicaDetached(ctx context.Context, client *goApiAbrha.Client, clusterID, replicaName string, timeout time.Duration) error {
+	stateConf := &retry.StateChangeConf{
+		Pending: []string{"replica"},
+		Target:  []string{"detached"},
+		Refresh: func() (interface{}, string, error) {
+			replica, resp, err := client.Databases.GetReplica(ctx, clusterID, replicaName)
+			if err != nil {
+				if resp != nil && resp.StatusCode == 404 {
+					return replicaName, "detached", nil
+				}
+
+				return nil, "", fmt.Errorf("Error trying to read DatabaseReplica state: %s", err)
+			}
+
+			log.Printf("[DEBUG] Waiting for DatabaseReplica (%s) to be promoted. Status: %s", replicaName, replica.Status)
+			return replica, "replica", nil
+		},
+		Delay:      15 * time.Second,
+		Timeout:    timeout,
+		MinTimeout: 15 * time.Second,
+	}
+
+	_, err := stateConf.WaitForStateContext(ctx)
+	return err
+}
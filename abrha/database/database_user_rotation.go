@@ -0,0 +1,117 @@
+package database
+
+import (
+	"context"
+	"fmt"
+	"log"
+	"time"
+
+	goApiAbrha "github.com/abrhacom/go-api-abrha"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/schema"
+)
+
+// planDatabaseUserPasswordRotation marks the password of a database user as
+// changing when its rotation triggers change or when the rotation interval has
+// elapsed since the last rotation.
+func planDatabaseUserPasswordRotation() schema.CustomizeDiffFunc {
+	return schema.CustomizeDiffFunc(func(ctx context.Context, diff *schema.ResourceDiff, v interface{}) error {
+		if diff.Id() == "" {
+			return nil
+		}
+
+		rotatedAt, _ := diff.GetChange("password_rotated_at")
+		if !diff.HasChange("rotation_triggers") && !passwordRotationDue(rotatedAt.(string), diff.Get("password_rotation_days").(int), time.Now()) {
+			return nil
+		}
+
+		for _, key := range []string{"password", "password_rotated_at", "previous_password", "previous_password_expires_at"} {
+			if err := diff.SetNewComputed(key); err != nil {
+				return err
+			}
+		}
+
+		return nil
+	})
+}
+
+// passwordRotationDue reports whether a password last rotated at rotatedAt
+// must be rotated again given a rotation interval in days. A zero interval
+// never requires rotation, while an unknown rotation time, as for imported
+// users or users created before rotation was supported, always does.
+func passwordRotationDue(rotatedAt string, days int, now time.Time) bool {
+	if days <= 0 {
+		return false
+	}
+
+	if rotatedAt == "" {
+		return true
+	}
+
+	last, err := time.Parse(time.RFC3339, rotatedAt)
+	if err != nil {
+		log.Printf("[WARN] Unable to parse password_rotated_at %q: %s", rotatedAt, err)
+		return false
+	}
+
+	return !now.Before(last.AddDate(0, 0, days))
+}
+
+// rotateDatabaseUserPassword regenerates the password of a database user in
+// place, keeping the previous password in state for the grace period.
+func rotateDatabaseUserPassword(ctx context.Context, client *goApiAbrha.Client, d *schema.ResourceData) error {
+	clusterID := d.Get("cluster_id").(string)
+	name := d.Get("name").(string)
+	previousPassword, _ := d.GetChange("password")
+
+	authReq := &goApiAbrha.DatabaseResetUserAuthRequest{}
+	if plugin := d.Get("mysql_auth_plugin").(string); plugin != "" {
+		// Resetting the password of a MySQL user also sets its auth plugin,
+		// so send the current one to keep it unchanged.
+		authReq.MySQLSettings = &goApiAbrha.DatabaseMySQLUserSettings{
+			AuthPlugin: plugin,
+		}
+	}
+
+	log.Printf("[INFO] Rotating password of Database User: %s", d.Id())
+	user, _, err := client.Databases.ResetUserAuth(ctx, clusterID, name, authReq)
+	if err != nil {
+		return fmt.Errorf("Error rotating password for DatabaseUser: %s", err)
+	}
+
+	if user.Password == "" {
+		return fmt.Errorf("Error rotating password for DatabaseUser: no password returned")
+	}
+
+	now := time.Now().UTC()
+	gracePeriod := time.Duration(d.Get("previous_password_grace_period_hours").(int)) * time.Hour
+
+	d.Set("password", user.Password)
+	d.Set("password_rotated_at", now.Format(time.RFC3339))
+	if gracePeriod > 0 && previousPassword.(string) != "" {
+		d.Set("previous_password", previousPassword.(string))
+		d.Set("previous_password_expires_at", now.Add(gracePeriod).Format(time.RFC3339))
+	} else {
+		clearPreviousDatabaseUserPassword(d)
+	}
+
+	return nil
+}
+
+// expirePreviousDatabaseUserPassword removes the previous password from state
+// once its grace period has passed.
+func expirePreviousDatabaseUserPassword(d *schema.ResourceData, now time.Time) {
+	expiresAt := d.Get("previous_password_expires_at").(string)
+	if expiresAt == "" {
+		return
+	}
+
+	expiry, err := time.Parse(time.RFC3339, expiresAt)
+	if err != nil || !now.Before(expiry) {
+		clearPreviousDatabaseUserPassword(d)
+	}
+}
+
+func clearPreviousDatabaseUserPassword(d *schema.ResourceData) {
+	d.Set("previous_password", "")
+	d.Set("previous_password_expires_at", "")
+}
@@ -0,0 +1,99 @@
+package database
+
+import (
+	"context"
+	"testing"
+	"time"
+
+	"github.com/hashicorp/terraform-plugin-sdk/v2/terraform"
+)
+
+func TestPasswordRotationDue(t *testing.T) {
+	t.Parallel()
+
+	now := time.Date(2024, 3, 10, 12, 0, 0, 0, time.UTC)
+
+	tt := []struct {
+		name      string
+		rotatedAt string
+		days      int
+		expected  bool
+	}{
+		{name: "disabled", rotatedAt: "2024-01-01T00:00:00Z", days: 0, expected: false},
+		{name: "disabled without rotation time", rotatedAt: "", days: 0, expected: false},
+		{name: "empty rotation time", rotatedAt: "", days: 30, expected: true},
+		{name: "not due", rotatedAt: "2024-03-01T12:00:00Z", days: 30, expected: false},
+		{name: "due", rotatedAt: "2024-02-01T12:00:00Z", days: 30, expected: true},
+		{name: "due exactly at interval", rotatedAt: "2024-03-09T12:00:00Z", days: 1, expected: true},
+		{name: "invalid rotation time", rotatedAt: "yesterday", days: 30, expected: false},
+	}
+
+	for _, tc := range tt {
+		t.Run(tc.name, func(t *testing.T) {
+			if got := passwordRotationDue(tc.rotatedAt, tc.days, now); got != tc.expected {
+				t.Errorf("passwordRotationDue(%q, %d) = %t, expected %t", tc.rotatedAt, tc.days, got, tc.expected)
+			}
+		})
+	}
+}
+
+func TestPlanDatabaseUserPasswordRotation(t *testing.T) {
+	t.Parallel()
+
+	dayAgo := time.Now().UTC().Add(-24 * time.Hour).Format(time.RFC3339)
+	monthAgo := time.Now().UTC().AddDate(0, -1, -1).Format(time.RFC3339)
+
+	tt := []struct {
+		name         string
+		rotatedAt    string
+		rotationDays string
+		expected     bool
+	}{
+		{name: "disabled", rotatedAt: monthAgo, rotationDays: "", expected: false},
+		{name: "empty rotation time", rotatedAt: "", rotationDays: "30", expected: true},
+		{name: "not due", rotatedAt: dayAgo, rotationDays: "30", expected: false},
+		{name: "due", rotatedAt: monthAgo, rotationDays: "30", expected: true},
+	}
+
+	for _, tc := range tt {
+		t.Run(tc.name, func(t *testing.T) {
+			state := &terraform.InstanceState{
+				ID: "cluster-id/user/foo",
+				Attributes: map[string]string{
+					"id":                                   "cluster-id/user/foo",
+					"cluster_id":                           "cluster-id",
+					"name":                                 "foo",
+					"role":                                 "normal",
+					"password":                             "secret",
+					"password_rotated_at":                  tc.rotatedAt,
+					"previous_password_grace_period_hours": "24",
+				},
+			}
+
+			config := map[string]interface{}{
+				"cluster_id": "cluster-id",
+				"name":       "foo",
+			}
+			if tc.rotationDays != "" {
+				state.Attributes["password_rotation_days"] = tc.rotationDays
+				config["password_rotation_days"] = tc.rotationDays
+			}
+
+			diff, err := ResourceAbrhaDatabaseUser().SimpleDiff(context.Background(), state, terraform.NewResourceConfigRaw(config), nil)
+			if err != nil {
+				t.Fatalf("unexpected error: %s", err)
+			}
+
+			var rotates bool
+			if diff != nil {
+				if attr, ok := diff.Attributes["password"]; ok && attr.NewComputed {
+					rotates = true
+				}
+			}
+
+			if rotates != tc.expected {
+				t.Errorf("expected password rotation to be planned: %t, got: %t", tc.expected, rotates)
+			}
+		})
+	}
+}
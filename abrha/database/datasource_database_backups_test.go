@@ -0,0 +1,53 @@
+package database_test
+
+import (
+	"fmt"
+	"testing"
+
+	goApiAbrha "github.com/abrhacom/go-api-abrha"
+	"github.com/abrhacom/terraform-provider-abrha/abrha/acceptance"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/resource"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/terraform"
+)
+
+func TestAccDataSourceAbrhaDatabaseBackups_Basic(t *testing.T) {
+	var database goApiAbrha.Database
+	databaseName := acceptance.RandomTestName()
+
+	resourceConfig := fmt.Sprintf(testAccCheckAbrhaDatabaseClusterConfigBasic, databaseName)
+
+	datasourceConfig := `
+data "abrha_database_backups" "result" {
+  cluster_id = abrha_database_cluster.foobar.id
+
+  sort {
+    key       = "created_at"
+    direction = "desc"
+  }
+}
+`
+
+	resource.ParallelTest(t, resource.TestCase{
+		PreCheck:          func() { acceptance.TestAccPreCheck(t) },
+		ProviderFactories: acceptance.TestAccProviderFactories,
+		CheckDestroy:      testAccCheckAbrhaDatabaseClusterDestroy,
+		Steps: []resource.TestStep{
+			{
+				Config: resourceConfig,
+				Check: resource.ComposeTestCheckFunc(
+					testAccCheckAbrhaDatabaseClusterExists("abrha_database_cluster.foobar", &database),
+					func(s *terraform.State) error {
+						return waitForDatabaseBackups(databaseName)
+					},
+				),
+			},
+			{
+				Config: resourceConfig + datasourceConfig,
+				Check: resource.ComposeTestCheckFunc(
+					resource.TestCheckResourceAttrSet("data.abrha_database_backups.result", "backups.0.created_at"),
+					resource.TestCheckResourceAttrSet("data.abrha_database_backups.result", "backups.0.size_gigabytes"),
+				),
+			},
+		},
+	})
+}
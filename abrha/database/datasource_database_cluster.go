@@ -65,6 +65,19 @@ func DataSourceAbrhaDatabaseCluster() *schema.Resource {
 				},
 			},
 
+			"maintenance_updates_pending": {
+				Type:        schema.TypeBool,
+				Computed:    true,
+				Description: "Whether the cluster has maintenance updates pending installation",
+			},
+
+			"maintenance_updates_description": {
+				Type:        schema.TypeList,
+				Computed:    true,
+				Elem:        &schema.Schema{Type: schema.TypeString},
+				Description: "Descriptions of the maintenance updates pending installation",
+			},
+
 			"host": {
 				Type:     schema.TypeString,
 				Computed: true,
@@ -220,6 +233,11 @@ func dataSourceAbrhaDatabaseClusterRead(ctx context.Context, d *schema.ResourceD
 				}
 			}
 
+			if db.MaintenanceWindow != nil {
+				d.Set("maintenance_updates_pending", db.MaintenanceWindow.Pending)
+				d.Set("maintenance_updates_description", db.MaintenanceWindow.Description)
+			}
+
 			err := setDatabaseConnectionInfo(&db, d)
 			if err != nil {
 				return diag.Errorf("Error setting connection info for database cluster: %s", err)
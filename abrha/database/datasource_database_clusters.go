@@ -0,0 +1,17 @@
+package database
+
+import (
+	"github.com/abrhacom/terraform-provider-abrha/internal/datalist"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/schema"
+)
+
+func DataSourceAbrhaDatabaseClusters() *schema.Resource {
+	dataListConfig := &datalist.ResourceConfig{
+		RecordSchema:        databaseClusterSchema(),
+		ResultAttributeName: "database_clusters",
+		GetRecords:          getAbrhaDatabaseClusters,
+		FlattenRecord:       flattenAbrhaDatabaseCluster,
+	}
+
+	return datalist.NewResource(dataListConfig)
+}
@@ -0,0 +1,63 @@
+package database_test
+
+import (
+	"fmt"
+	"testing"
+
+	"github.com/abrhacom/terraform-provider-abrha/abrha/acceptance"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/resource"
+)
+
+func TestAccDataSourceAbrhaDatabaseClusters_Basic(t *testing.T) {
+	databaseName := acceptance.RandomTestName()
+
+	resourceConfig := fmt.Sprintf(`
+resource "abrha_database_cluster" "foobar" {
+  name       = "%s"
+  engine     = "pg"
+  version    = "15"
+  size       = "db-s-1vcpu-1gb"
+  region     = "nyc1"
+  node_count = 1
+  tags       = ["production"]
+}
+`, databaseName)
+
+	datasourceConfig := fmt.Sprintf(`
+data "abrha_database_clusters" "result" {
+  filter {
+    key    = "name"
+    values = ["%s"]
+  }
+
+  filter {
+    key    = "engine"
+    values = ["pg"]
+  }
+}
+`, databaseName)
+
+	resource.ParallelTest(t, resource.TestCase{
+		PreCheck:          func() { acceptance.TestAccPreCheck(t) },
+		ProviderFactories: acceptance.TestAccProviderFactories,
+		Steps: []resource.TestStep{
+			{
+				Config: resourceConfig,
+			},
+			{
+				Config: resourceConfig + datasourceConfig,
+				Check: resource.ComposeTestCheckFunc(
+					resource.TestCheckResourceAttr("data.abrha_database_clusters.result", "database_clusters.#", "1"),
+					resource.TestCheckResourceAttr("data.abrha_database_clusters.result", "database_clusters.0.name", databaseName),
+					resource.TestCheckResourceAttr("data.abrha_database_clusters.result", "database_clusters.0.engine", "pg"),
+					resource.TestCheckResourceAttr("data.abrha_database_clusters.result", "database_clusters.0.region", "nyc1"),
+					resource.TestCheckResourceAttr("data.abrha_database_clusters.result", "database_clusters.0.tags.#", "1"),
+					resource.TestCheckResourceAttrPair("data.abrha_database_clusters.result", "database_clusters.0.id", "abrha_database_cluster.foobar", "id"),
+				),
+			},
+			{
+				Config: resourceConfig,
+			},
+		},
+	})
+}
@@ -0,0 +1,52 @@
+package database_test
+
+import (
+	"fmt"
+	"testing"
+
+	goApiAbrha "github.com/abrhacom/go-api-abrha"
+	"github.com/abrhacom/terraform-provider-abrha/abrha/acceptance"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/resource"
+)
+
+func TestAccDataSourceAbrhaDatabaseEvents_Basic(t *testing.T) {
+	var database goApiAbrha.Database
+	databaseName := acceptance.RandomTestName()
+
+	resourceConfig := fmt.Sprintf(testAccCheckAbrhaDatabaseClusterConfigBasic, databaseName)
+
+	datasourceConfig := `
+data "abrha_database_events" "result" {
+  cluster_id = abrha_database_cluster.foobar.id
+
+  filter {
+    key    = "event_type"
+    values = ["cluster_create"]
+  }
+}
+`
+
+	resource.ParallelTest(t, resource.TestCase{
+		PreCheck:          func() { acceptance.TestAccPreCheck(t) },
+		ProviderFactories: acceptance.TestAccProviderFactories,
+		CheckDestroy:      testAccCheckAbrhaDatabaseClusterDestroy,
+		Steps: []resource.TestStep{
+			{
+				Config: resourceConfig,
+				Check: resource.ComposeTestCheckFunc(
+					testAccCheckAbrhaDatabaseClusterExists("abrha_database_cluster.foobar", &database),
+				),
+			},
+			{
+				Config: resourceConfig + datasourceConfig,
+				Check: resource.ComposeTestCheckFunc(
+					resource.TestCheckResourceAttr("data.abrha_database_events.result", "events.#", "1"),
+					resource.TestCheckResourceAttr("data.abrha_database_events.result", "events.0.cluster_name", databaseName),
+					resource.TestCheckResourceAttr("data.abrha_database_events.result", "events.0.event_type", "cluster_create"),
+					resource.TestCheckResourceAttrSet("data.abrha_database_events.result", "events.0.id"),
+					resource.TestCheckResourceAttrSet("data.abrha_database_events.result", "events.0.created_at"),
+				),
+			},
+		},
+	})
+}
@@ -0,0 +1,25 @@
+package database
+
+import (
+	"github.com/abrhacom/terraform-provider-abrha/internal/datalist"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/schema"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/validation"
+)
+
+func DataSourceAbrhaDatabaseOpensearchIndexes() *schema.Resource {
+	dataListConfig := &datalist.ResourceConfig{
+		RecordSchema:        databaseOpensearchIndexSchema(),
+		ResultAttributeName: "indexes",
+		ExtraQuerySchema: map[string]*schema.Schema{
+			"cluster_id": {
+				Type:         schema.TypeString,
+				Required:     true,
+				ValidateFunc: validation.NoZeroValues,
+			},
+		},
+		GetRecords:    getAbrhaDatabaseOpensearchIndexes,
+		FlattenRecord: flattenAbrhaDatabaseOpensearchIndex,
+	}
+
+	return datalist.NewResource(dataListConfig)
+}
@@ -0,0 +1,37 @@
+package database_test
+
+import (
+	"fmt"
+	"testing"
+
+	"github.com/abrhacom/terraform-provider-abrha/abrha/acceptance"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/resource"
+)
+
+func TestAccDataSourceAbrhaDatabaseOpensearchIndexes_Basic(t *testing.T) {
+	name := acceptance.RandomTestName()
+	dbConfig := fmt.Sprintf(testAccCheckAbrhaDatabaseClusterOpensearch, name, "2")
+
+	datasourceConfig := `
+data "abrha_database_opensearch_indexes" "result" {
+  cluster_id = abrha_database_cluster.foobar.id
+}`
+
+	resource.ParallelTest(t, resource.TestCase{
+		PreCheck:          func() { acceptance.TestAccPreCheck(t) },
+		ProviderFactories: acceptance.TestAccProviderFactories,
+		CheckDestroy:      testAccCheckAbrhaDatabaseClusterDestroy,
+		Steps: []resource.TestStep{
+			{
+				Config: dbConfig,
+			},
+			{
+				Config: dbConfig + datasourceConfig,
+				Check: resource.ComposeTestCheckFunc(
+					resource.TestCheckResourceAttrSet(
+						"data.abrha_database_opensearch_indexes.result", "indexes.#"),
+				),
+			},
+		},
+	})
+}
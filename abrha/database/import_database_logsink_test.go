@@ -0,0 +1,56 @@
+package database_test
+
+import (
+	"fmt"
+	"regexp"
+	"testing"
+
+	"github.com/abrhacom/terraform-provider-abrha/abrha/acceptance"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/resource"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/terraform"
+)
+
+func TestAccAbrhaDatabaseLogsink_importBasic(t *testing.T) {
+	resourceName := "abrha_database_logsink.foobar"
+	name := acceptance.RandomTestName()
+	dbConfig := fmt.Sprintf(testAccCheckAbrhaDatabaseClusterPostgreSQL, name, "15")
+
+	resource.ParallelTest(t, resource.TestCase{
+		PreCheck:          func() { acceptance.TestAccPreCheck(t) },
+		ProviderFactories: acceptance.TestAccProviderFactories,
+		CheckDestroy:      testAccCheckAbrhaDatabaseLogsinkDestroy,
+		Steps: []resource.TestStep{
+			{
+				Config: fmt.Sprintf(testAccCheckAbrhaDatabaseLogsinkRsyslog, dbConfig, 514, "rfc5424"),
+			},
+			{
+				ResourceName:      resourceName,
+				ImportState:       true,
+				ImportStateVerify: true,
+				// Requires passing both the cluster ID and logsink ID
+				ImportStateIdFunc: testAccDatabaseLogsinkImportID(resourceName),
+			},
+			{
+				ResourceName:      resourceName,
+				ImportState:       true,
+				ImportStateVerify: false,
+				ImportStateId:     "logsink",
+				ExpectError:       regexp.MustCompile("joined with a comma"),
+			},
+		},
+	})
+}
+
+func testAccDatabaseLogsinkImportID(n string) resource.ImportStateIdFunc {
+	return func(s *terraform.State) (string, error) {
+		rs, ok := s.RootModule().Resources[n]
+		if !ok {
+			return "", fmt.Errorf("Not found: %s", n)
+		}
+
+		clusterID := rs.Primary.Attributes["cluster_id"]
+		logsinkID := rs.Primary.Attributes["logsink_id"]
+
+		return fmt.Sprintf("%s,%s", clusterID, logsinkID), nil
+	}
+}
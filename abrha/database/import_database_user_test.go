@@ -24,9 +24,10 @@ func TestAccAbrhaDatabaseUser_importBasic(t *testing.T) {
 				Config: fmt.Sprintf(testAccCheckAbrhaDatabaseUserConfigBasic, databaseClusterName, databaseUserName),
 			},
 			{
-				ResourceName:      resourceName,
-				ImportState:       true,
-				ImportStateVerify: true,
+				ResourceName:            resourceName,
+				ImportState:             true,
+				ImportStateVerify:       true,
+				ImportStateVerifyIgnore: []string{"password_rotated_at"}, // We ignore this as it is only tracked by Terraform
 				// Requires passing both the cluster ID and user name
 				ImportStateIdFunc: testAccDatabaseUserImportID(resourceName),
 			},
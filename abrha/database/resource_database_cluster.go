@@ -119,6 +119,19 @@ func ResourceAbrhaDatabaseCluster() *schema.Resource {
 				},
 			},
 
+			"maintenance_updates_pending": {
+				Type:        schema.TypeBool,
+				Computed:    true,
+				Description: "Whether the cluster has maintenance updates pending installation",
+			},
+
+			"maintenance_updates_description": {
+				Type:        schema.TypeList,
+				Computed:    true,
+				Elem:        &schema.Schema{Type: schema.TypeString},
+				Description: "Descriptions of the maintenance updates pending installation",
+			},
+
 			"eviction_policy": {
 				Type:         schema.TypeString,
 				Optional:     true,
@@ -251,15 +264,39 @@ func ResourceAbrhaDatabaseCluster() *schema.Resource {
 				Optional: true,
 				Computed: true,
 			},
+
+			"safe_version_upgrade": {
+				Type:        schema.TypeBool,
+				Optional:    true,
+				Default:     false,
+				Description: "Whether to reject downgrades and skipped major versions at plan time and to wait for the cluster to come back online at the new version after an upgrade",
+			},
+
+			"require_pre_upgrade_backup": {
+				Type:         schema.TypeBool,
+				Optional:     true,
+				Default:      false,
+				RequiredWith: []string{"safe_version_upgrade"},
+				Description:  "Whether a major version upgrade requires an existing backup taken within the last 24 hours. No backup is created on demand; the upgrade fails if the latest scheduled backup is older.",
+			},
+
+			"pre_upgrade_backup_created_at": {
+				Type:        schema.TypeString,
+				Computed:    true,
+				Description: "The creation time of the most recent backup verified before the last major version upgrade",
+			},
 		},
 
 		Timeouts: &schema.ResourceTimeout{
 			Create: schema.DefaultTimeout(30 * time.Minute),
+			Update: schema.DefaultTimeout(60 * time.Minute),
 		},
 
 		CustomizeDiff: customdiff.All(
 			transitionVersionToRequired(),
 			validateExclusiveAttributes(),
+			validateSafeVersionUpgrade(),
+			planPreUpgradeBackup(),
 		),
 	}
 }
@@ -483,9 +520,7 @@ func resourceAbrhaDatabaseClusterUpdate(ctx context.Context, d *schema.ResourceD
 	}
 
 	if d.HasChange("version") {
-		upgradeVersionReq := &goApiAbrha.UpgradeVersionRequest{Version: d.Get("version").(string)}
-		_, err := client.Databases.UpgradeMajorVersion(context.Background(), d.Id(), upgradeVersionReq)
-		if err != nil {
+		if err := upgradeDatabaseClusterVersion(ctx, client, d); err != nil {
 			return diag.Errorf("Error upgrading version for database cluster: %s", err)
 		}
 	}
@@ -530,6 +565,11 @@ func resourceAbrhaDatabaseClusterRead(ctx context.Context, d *schema.ResourceDat
 		}
 	}
 
+	if database.MaintenanceWindow != nil {
+		d.Set("maintenance_updates_pending", database.MaintenanceWindow.Pending)
+		d.Set("maintenance_updates_description", database.MaintenanceWindow.Description)
+	}
+
 	if _, ok := d.GetOk("eviction_policy"); ok {
 		policy, _, err := client.Databases.GetEvictionPolicy(context.Background(), d.Id())
 		if err != nil {
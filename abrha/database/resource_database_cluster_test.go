@@ -572,6 +572,43 @@ func TestAccAbrhaDatabaseCluster_Upgrade(t *testing.T) {
 	})
 }
 
+func TestAccAbrhaDatabaseCluster_SafeUpgrade(t *testing.T) {
+	var database goApiAbrha.Database
+	databaseName := acceptance.RandomTestName()
+	previousPGVersion := "14"
+	latestPGVersion := "15"
+
+	resource.ParallelTest(t, resource.TestCase{
+		PreCheck:          func() { acceptance.TestAccPreCheck(t) },
+		ProviderFactories: acceptance.TestAccProviderFactories,
+		CheckDestroy:      testAccCheckAbrhaDatabaseClusterDestroy,
+		Steps: []resource.TestStep{
+			{
+				Config: fmt.Sprintf(testAccCheckAbrhaDatabaseClusterConfigSafeUpgrade, databaseName, previousPGVersion),
+				Check: resource.ComposeTestCheckFunc(
+					testAccCheckAbrhaDatabaseClusterExists(
+						"abrha_database_cluster.foobar", &database),
+					resource.TestCheckResourceAttr(
+						"abrha_database_cluster.foobar", "version", previousPGVersion),
+					resource.TestCheckResourceAttr(
+						"abrha_database_cluster.foobar", "safe_version_upgrade", "true"),
+				),
+			},
+			{
+				Config: fmt.Sprintf(testAccCheckAbrhaDatabaseClusterConfigSafeUpgrade, databaseName, latestPGVersion),
+				Check: resource.ComposeTestCheckFunc(
+					resource.TestCheckResourceAttr(
+						"abrha_database_cluster.foobar", "version", latestPGVersion),
+				),
+			},
+			{
+				Config:      fmt.Sprintf(testAccCheckAbrhaDatabaseClusterConfigSafeUpgrade, databaseName, previousPGVersion),
+				ExpectError: regexp.MustCompile("downgrading pg from version 15 to 14 is not supported"),
+			},
+		},
+	})
+}
+
 func TestAccAbrhaDatabaseCluster_nonDefaultProject(t *testing.T) {
 	var database goApiAbrha.Database
 	databaseName := acceptance.RandomTestName()
@@ -1011,6 +1048,17 @@ resource "abrha_database_cluster" "foobar" {
   node_count = 1
 }`
 
+const testAccCheckAbrhaDatabaseClusterConfigSafeUpgrade = `
+resource "abrha_database_cluster" "foobar" {
+  name                 = "%s"
+  engine               = "pg"
+  version              = "%s"
+  size                 = "db-s-1vcpu-1gb"
+  region               = "nyc3"
+  node_count           = 1
+  safe_version_upgrade = true
+}`
+
 const testAccCheckAbrhaDatabaseClusterConfigNonDefaultProject = `
 resource "abrha_project" "foobar" {
   name = "%s"
@@ -0,0 +1,383 @@
+package database
+
+import (
+	"context"
+	"errors"
+	"fmt"
+	"log"
+	"strings"
+
+	goApiAbrha "github.com/abrhacom/go-api-abrha"
+	"github.com/abrhacom/terraform-provider-abrha/abrha/config"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/diag"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/schema"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/validation"
+)
+
+const (
+	logsinkTypeRsyslog       = "rsyslog"
+	logsinkTypeElasticsearch = "elasticsearch"
+	logsinkTypeOpensearch    = "opensearch"
+)
+
+// logsinkConfigKeys maps each logsink type to the block used to configure it.
+var logsinkConfigKeys = map[string]string{
+	logsinkTypeRsyslog:       "rsyslog_config",
+	logsinkTypeElasticsearch: "elasticsearch_config",
+	logsinkTypeOpensearch:    "opensearch_config",
+}
+
+func ResourceAbrhaDatabaseLogsink() *schema.Resource {
+	return &schema.Resource{
+		CreateContext: resourceAbrhaDatabaseLogsinkCreate,
+		ReadContext:   resourceAbrhaDatabaseLogsinkRead,
+		UpdateContext: resourceAbrhaDatabaseLogsinkUpdate,
+		DeleteContext: resourceAbrhaDatabaseLogsinkDelete,
+		Importer: &schema.ResourceImporter{
+			State: resourceAbrhaDatabaseLogsinkImport,
+		},
+
+		Schema: map[string]*schema.Schema{
+			"cluster_id": {
+				Type:         schema.TypeString,
+				Required:     true,
+				ForceNew:     true,
+				ValidateFunc: validation.NoZeroValues,
+			},
+			"name": {
+				Type:         schema.TypeString,
+				Required:     true,
+				ForceNew:     true,
+				ValidateFunc: validation.NoZeroValues,
+			},
+			"type": {
+				Type:     schema.TypeString,
+				Computed: true,
+			},
+			"logsink_id": {
+				Type:     schema.TypeString,
+				Computed: true,
+			},
+			"rsyslog_config": {
+				Type:         schema.TypeList,
+				Optional:     true,
+				MaxItems:     1,
+				ExactlyOneOf: []string{"rsyslog_config", "elasticsearch_config", "opensearch_config"},
+				Elem: &schema.Resource{
+					Schema: map[string]*schema.Schema{
+						"server": {
+							Type:         schema.TypeString,
+							Required:     true,
+							ValidateFunc: validation.NoZeroValues,
+						},
+						"port": {
+							Type:         schema.TypeInt,
+							Required:     true,
+							ValidateFunc: validation.IsPortNumber,
+						},
+						"tls": {
+							Type:     schema.TypeBool,
+							Optional: true,
+						},
+						"format": {
+							Type:     schema.TypeString,
+							Required: true,
+							ValidateFunc: validation.StringInSlice([]string{
+								"rfc5424",
+								"rfc3164",
+								"custom",
+							}, false),
+						},
+						"logline": {
+							Type:     schema.TypeString,
+							Optional: true,
+						},
+						"sd": {
+							Type:     schema.TypeString,
+							Optional: true,
+						},
+						"ca": {
+							Type:     schema.TypeString,
+							Optional: true,
+						},
+						"key": {
+							Type:      schema.TypeString,
+							Optional:  true,
+							Sensitive: true,
+						},
+						"cert": {
+							Type:     schema.TypeString,
+							Optional: true,
+						},
+					},
+				},
+			},
+			"elasticsearch_config": {
+				Type:         schema.TypeList,
+				Optional:     true,
+				MaxItems:     1,
+				ExactlyOneOf: []string{"rsyslog_config", "elasticsearch_config", "opensearch_config"},
+				Elem:         logsinkSearchConfigSchema(),
+			},
+			"opensearch_config": {
+				Type:         schema.TypeList,
+				Optional:     true,
+				MaxItems:     1,
+				ExactlyOneOf: []string{"rsyslog_config", "elasticsearch_config", "opensearch_config"},
+				Elem:         logsinkSearchConfigSchema(),
+			},
+		},
+
+		CustomizeDiff: forceNewOnLogsinkTypeChange(),
+	}
+}
+
+// logsinkSearchConfigSchema is shared by the Elasticsearch and OpenSearch
+// logsink types, which accept the same options.
+func logsinkSearchConfigSchema() *schema.Resource {
+	return &schema.Resource{
+		Schema: map[string]*schema.Schema{
+			"url": {
+				Type:         schema.TypeString,
+				Required:     true,
+				Sensitive:    true,
+				Description:  "Connection URL, including any credentials required to authenticate",
+				ValidateFunc: validation.IsURLWithHTTPorHTTPS,
+			},
+			"index_prefix": {
+				Type:         schema.TypeString,
+				Required:     true,
+				ValidateFunc: validation.NoZeroValues,
+			},
+			"index_days_max": {
+				Type:         schema.TypeInt,
+				Optional:     true,
+				Default:      7,
+				ValidateFunc: validation.IntBetween(1, 10000),
+			},
+			"timeout": {
+				Type:         schema.TypeFloat,
+				Optional:     true,
+				Default:      10,
+				ValidateFunc: validation.FloatBetween(10, 120),
+			},
+			"ca": {
+				Type:     schema.TypeString,
+				Optional: true,
+			},
+		},
+	}
+}
+
+// forceNewOnLogsinkTypeChange replaces the logsink when switching between
+// types, as the API does not allow the type of an existing sink to change.
+func forceNewOnLogsinkTypeChange() schema.CustomizeDiffFunc {
+	return schema.CustomizeDiffFunc(func(ctx context.Context, diff *schema.ResourceDiff, v interface{}) error {
+		if diff.Id() == "" {
+			return nil
+		}
+
+		for _, key := range logsinkConfigKeys {
+			o, n := diff.GetChange(key)
+			if len(o.([]interface{})) != len(n.([]interface{})) {
+				if err := diff.ForceNew(key); err != nil {
+					return err
+				}
+			}
+		}
+
+		return nil
+	})
+}
+
+func resourceAbrhaDatabaseLogsinkCreate(ctx context.Context, d *schema.ResourceData, meta interface{}) diag.Diagnostics {
+	client := meta.(*config.CombinedConfig).GoApiAbrhaClient()
+	clusterID := d.Get("cluster_id").(string)
+
+	sinkType, sinkConfig := expandLogsinkConfig(d)
+	opts := &goApiAbrha.DatabaseCreateLogsinkRequest{
+		Name:   d.Get("name").(string),
+		Type:   sinkType,
+		Config: sinkConfig,
+	}
+
+	log.Printf("[DEBUG] Database logsink create configuration: name %s, type %s", opts.Name, opts.Type)
+	logsink, _, err := client.Databases.CreateLogsink(context.Background(), clusterID, opts)
+	if err != nil {
+		return diag.Errorf("Error creating database logsink: %s", err)
+	}
+
+	d.SetId(makeDatabaseLogsinkID(clusterID, logsink.ID))
+	d.Set("logsink_id", logsink.ID)
+	log.Printf("[INFO] Database logsink ID: %s", logsink.ID)
+
+	return resourceAbrhaDatabaseLogsinkRead(ctx, d, meta)
+}
+
+func resourceAbrhaDatabaseLogsinkRead(ctx context.Context, d *schema.ResourceData, meta interface{}) diag.Diagnostics {
+	client := meta.(*config.CombinedConfig).GoApiAbrhaClient()
+	clusterID := d.Get("cluster_id").(string)
+	logsinkID := d.Get("logsink_id").(string)
+
+	logsink, resp, err := client.Databases.GetLogsink(context.Background(), clusterID, logsinkID)
+	if err != nil {
+		if resp != nil && resp.StatusCode == 404 {
+			log.Printf("[DEBUG] Database logsink (%s) was not found - removing from state", d.Id())
+			d.SetId("")
+			return nil
+		}
+
+		return diag.Errorf("Error retrieving database logsink: %s", err)
+	}
+
+	d.Set("name", logsink.Name)
+	d.Set("type", logsink.Type)
+
+	for sinkType, key := range logsinkConfigKeys {
+		value := []interface{}{}
+		if sinkType == logsink.Type {
+			value = flattenLogsinkConfig(logsink.Type, logsink.Config, d.Get(key).([]interface{}))
+		}
+		if err := d.Set(key, value); err != nil {
+			return diag.Errorf("Error setting %s: %#v", key, err)
+		}
+	}
+
+	return nil
+}
+
+func resourceAbrhaDatabaseLogsinkUpdate(ctx context.Context, d *schema.ResourceData, meta interface{}) diag.Diagnostics {
+	client := meta.(*config.CombinedConfig).GoApiAbrhaClient()
+	clusterID := d.Get("cluster_id").(string)
+	logsinkID := d.Get("logsink_id").(string)
+
+	_, sinkConfig := expandLogsinkConfig(d)
+	opts := &goApiAbrha.DatabaseUpdateLogsinkRequest{
+		Config: sinkConfig,
+	}
+
+	log.Printf("[DEBUG] Updating database logsink: %s", d.Id())
+	_, err := client.Databases.UpdateLogsink(context.Background(), clusterID, logsinkID, opts)
+	if err != nil {
+		return diag.Errorf("Error updating database logsink: %s", err)
+	}
+
+	return resourceAbrhaDatabaseLogsinkRead(ctx, d, meta)
+}
+
+func resourceAbrhaDatabaseLogsinkDelete(ctx context.Context, d *schema.ResourceData, meta interface{}) diag.Diagnostics {
+	client := meta.(*config.CombinedConfig).GoApiAbrhaClient()
+	clusterID := d.Get("cluster_id").(string)
+	logsinkID := d.Get("logsink_id").(string)
+
+	log.Printf("[INFO] Deleting database logsink: %s", d.Id())
+	resp, err := client.Databases.DeleteLogsink(context.Background(), clusterID, logsinkID)
+	if err != nil {
+		if resp != nil && resp.StatusCode == 404 {
+			d.SetId("")
+			return nil
+		}
+		return diag.Errorf("Error deleting database logsink: %s", err)
+	}
+
+	d.SetId("")
+	return nil
+}
+
+func resourceAbrhaDatabaseLogsinkImport(d *schema.ResourceData, meta interface{}) ([]*schema.ResourceData, error) {
+	if strings.Contains(d.Id(), ",") {
+		s := strings.Split(d.Id(), ",")
+		d.SetId(makeDatabaseLogsinkID(s[0], s[1]))
+		d.Set("cluster_id", s[0])
+		d.Set("logsink_id", s[1])
+	} else {
+		return nil, errors.New("must use the ID of the source database cluster and the ID of the logsink joined with a comma (e.g. `id,logsink_id`)")
+	}
+
+	return []*schema.ResourceData{d}, nil
+}
+
+func makeDatabaseLogsinkID(clusterID string, logsinkID string) string {
+	return fmt.Sprintf("%s/logsink/%s", clusterID, logsinkID)
+}
+
+func expandLogsinkConfig(d *schema.ResourceData) (string, *goApiAbrha.DatabaseLogsinkConfig) {
+	if v, ok := d.GetOk("rsyslog_config"); ok {
+		raw := v.([]interface{})[0].(map[string]interface{})
+		return logsinkTypeRsyslog, &goApiAbrha.DatabaseLogsinkConfig{
+			Server:  raw["server"].(string),
+			Port:    raw["port"].(int),
+			TLS:     raw["tls"].(bool),
+			Format:  raw["format"].(string),
+			Logline: raw["logline"].(string),
+			SD:      raw["sd"].(string),
+			CA:      raw["ca"].(string),
+			Key:     raw["key"].(string),
+			Cert:    raw["cert"].(string),
+		}
+	}
+
+	sinkType := logsinkTypeOpensearch
+	v, ok := d.GetOk("opensearch_config")
+	if !ok {
+		sinkType = logsinkTypeElasticsearch
+		v = d.Get("elasticsearch_config")
+	}
+
+	raw := v.([]interface{})[0].(map[string]interface{})
+	return sinkType, &goApiAbrha.DatabaseLogsinkConfig{
+		URL:          raw["url"].(string),
+		IndexPrefix:  raw["index_prefix"].(string),
+		IndexDaysMax: raw["index_days_max"].(int),
+		Timeout:      float32(raw["timeout"].(float64)),
+		CA:           raw["ca"].(string),
+	}
+}
+
+// flattenLogsinkConfig converts the API representation of a logsink's config
+// into its Terraform block. Credentials are not always returned by the API, so
+// the values already in state are kept when the response omits them.
+func flattenLogsinkConfig(sinkType string, sinkConfig *goApiAbrha.DatabaseLogsinkConfig, current []interface{}) []interface{} {
+	if sinkConfig == nil {
+		return []interface{}{}
+	}
+
+	prior := map[string]interface{}{}
+	if len(current) > 0 && current[0] != nil {
+		prior = current[0].(map[string]interface{})
+	}
+	keepPrior := func(key string, value string) string {
+		if value == "" {
+			if v, ok := prior[key].(string); ok {
+				return v
+			}
+		}
+		return value
+	}
+
+	if sinkType == logsinkTypeRsyslog {
+		return []interface{}{
+			map[string]interface{}{
+				"server":  sinkConfig.Server,
+				"port":    sinkConfig.Port,
+				"tls":     sinkConfig.TLS,
+				"format":  sinkConfig.Format,
+				"logline": sinkConfig.Logline,
+				"sd":      sinkConfig.SD,
+				"ca":      sinkConfig.CA,
+				"key":     keepPrior("key", sinkConfig.Key),
+				"cert":    keepPrior("cert", sinkConfig.Cert),
+			},
+		}
+	}
+
+	return []interface{}{
+		map[string]interface{}{
+			"url":            keepPrior("url", sinkConfig.URL),
+			"index_prefix":   sinkConfig.IndexPrefix,
+			"index_days_max": sinkConfig.IndexDaysMax,
+			"timeout":        float64(sinkConfig.Timeout),
+			"ca":             sinkConfig.CA,
+		},
+	}
+}
@@ -0,0 +1,130 @@
+package database
+
+import (
+	"context"
+	"fmt"
+	"log"
+	"time"
+
+	goApiAbrha "github.com/abrhacom/go-api-abrha"
+	"github.com/abrhacom/terraform-provider-abrha/abrha/config"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/diag"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/retry"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/schema"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/validation"
+)
+
+func ResourceAbrhaDatabaseMaintenanceUpdate() *schema.Resource {
+	return &schema.Resource{
+		CreateContext: resourceAbrhaDatabaseMaintenanceUpdateCreate,
+		ReadContext:   resourceAbrhaDatabaseMaintenanceUpdateRead,
+		DeleteContext: resourceAbrhaDatabaseMaintenanceUpdateDelete,
+
+		Schema: map[string]*schema.Schema{
+			"cluster_id": {
+				Type:         schema.TypeString,
+				Required:     true,
+				ForceNew:     true,
+				ValidateFunc: validation.NoZeroValues,
+				Description:  "The ID of the database cluster to install pending updates on",
+			},
+
+			"triggers": {
+				Type:        schema.TypeMap,
+				Optional:    true,
+				ForceNew:    true,
+				Elem:        &schema.Schema{Type: schema.TypeString},
+				Description: "Arbitrary map of values that, when changed, will install pending updates again",
+			},
+
+			"installed_at": {
+				Type:        schema.TypeString,
+				Computed:    true,
+				Description: "The date and time the update installation finished",
+			},
+
+			"updates_pending": {
+				Type:        schema.TypeBool,
+				Computed:    true,
+				Description: "Whether the cluster still has maintenance updates pending",
+			},
+		},
+
+		Timeouts: &schema.ResourceTimeout{
+			Create: schema.DefaultTimeout(60 * time.Minute),
+		},
+	}
+}
+
+func resourceAbrhaDatabaseMaintenanceUpdateCreate(ctx context.Context, d *schema.ResourceData, meta interface{}) diag.Diagnostics {
+	client := meta.(*config.CombinedConfig).GoApiAbrhaClient()
+	clusterID := d.Get("cluster_id").(string)
+
+	log.Printf("[INFO] Installing pending updates on database cluster (%s)", clusterID)
+	_, err := client.Databases.InstallUpdate(ctx, clusterID)
+	if err != nil {
+		return diag.Errorf("Error installing updates on database cluster (%s): %s", clusterID, err)
+	}
+
+	d.SetId(fmt.Sprintf("%s/update/%d", clusterID, time.Now().UTC().Unix()))
+
+	if err := waitForDatabaseClusterOnline(ctx, client, clusterID, d.Timeout(schema.TimeoutCreate)); err != nil {
+		return diag.Errorf("Error waiting for updates to be installed on database cluster (%s): %s", clusterID, err)
+	}
+
+	d.Set("installed_at", time.Now().UTC().Format(time.RFC3339))
+
+	return resourceAbrhaDatabaseMaintenanceUpdateRead(ctx, d, meta)
+}
+
+func resourceAbrhaDatabaseMaintenanceUpdateRead(ctx context.Context, d *schema.ResourceData, meta interface{}) diag.Diagnostics {
+	client := meta.(*config.CombinedConfig).GoApiAbrhaClient()
+	clusterID := d.Get("cluster_id").(string)
+
+	database, resp, err := client.Databases.Get(ctx, clusterID)
+	if err != nil {
+		if resp != nil && resp.StatusCode == 404 {
+			log.Printf("[WARN] Database cluster (%s) not found, removing maintenance update from state", clusterID)
+			d.SetId("")
+			return nil
+		}
+
+		return diag.Errorf("Error retrieving database cluster: %s", err)
+	}
+
+	d.Set("updates_pending", database.MaintenanceWindow != nil && database.MaintenanceWindow.Pending)
+
+	return nil
+}
+
+func resourceAbrhaDatabaseMaintenanceUpdateDelete(ctx context.Context, d *schema.ResourceData, meta interface{}) diag.Diagnostics {
+	// Installed updates cannot be reverted; removing the resource only drops it from state.
+	d.SetId("")
+	return nil
+}
+
+// waitForDatabaseClusterOnline waits for a cluster to leave maintenance and be
+// online again. The cluster can report online for a short while after an
+// operation was requested, so the status must be seen repeatedly.
+func waitForDatabaseClusterOnline(ctx context.Context, client *goApiAbrha.Client, clusterID string, timeout time.Duration) error {
+	stateConf := &retry.StateChangeConf{
+		Pending: []string{"creating", "migrating", "resizing", "forking", "upgrading", "maintenance"},
+		Target:  []string{"online"},
+		Refresh: func() (interface{}, string, error) {
+			database, _, err := client.Databases.Get(ctx, clusterID)
+			if err != nil {
+				return nil, "", fmt.Errorf("Error trying to read database cluster state: %s", err)
+			}
+
+			log.Printf("[DEBUG] Waiting for database cluster (%s) to become online. Status: %s", clusterID, database.Status)
+			return database, database.Status, nil
+		},
+		Delay:                     30 * time.Second,
+		Timeout:                   timeout,
+		MinTimeout:                15 * time.Second,
+		ContinuousTargetOccurence: 2,
+	}
+
+	_, err := stateConf.WaitForStateContext(ctx)
+	return err
+}
@@ -0,0 +1,54 @@
+package database_test
+
+import (
+	"fmt"
+	"testing"
+
+	"github.com/abrhacom/terraform-provider-abrha/abrha/acceptance"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/resource"
+)
+
+func TestAccAbrhaDatabaseMaintenanceUpdate_Basic(t *testing.T) {
+	name := acceptance.RandomTestName()
+	dbConfig := fmt.Sprintf(testAccCheckAbrhaDatabaseClusterConfigBasic, name)
+
+	resource.ParallelTest(t, resource.TestCase{
+		PreCheck:          func() { acceptance.TestAccPreCheck(t) },
+		ProviderFactories: acceptance.TestAccProviderFactories,
+		CheckDestroy:      testAccCheckAbrhaDatabaseClusterDestroy,
+		Steps: []resource.TestStep{
+			{
+				Config: fmt.Sprintf(testAccCheckAbrhaDatabaseMaintenanceUpdateConfig, dbConfig, "1"),
+				Check: resource.ComposeTestCheckFunc(
+					resource.TestCheckResourceAttrPair(
+						"abrha_database_maintenance_update.foobar", "cluster_id",
+						"abrha_database_cluster.foobar", "id"),
+					resource.TestCheckResourceAttrSet(
+						"abrha_database_maintenance_update.foobar", "installed_at"),
+					resource.TestCheckResourceAttrSet(
+						"abrha_database_maintenance_update.foobar", "updates_pending"),
+					resource.TestCheckResourceAttrSet(
+						"abrha_database_cluster.foobar", "maintenance_updates_pending"),
+				),
+			},
+			{
+				Config: fmt.Sprintf(testAccCheckAbrhaDatabaseMaintenanceUpdateConfig, dbConfig, "2"),
+				Check: resource.ComposeTestCheckFunc(
+					resource.TestCheckResourceAttr(
+						"abrha_database_maintenance_update.foobar", "triggers.rollout", "2"),
+				),
+			},
+		},
+	})
+}
+
+const testAccCheckAbrhaDatabaseMaintenanceUpdateConfig = `
+%s
+
+resource "abrha_database_maintenance_update" "foobar" {
+  cluster_id = abrha_database_cluster.foobar.id
+
+  triggers = {
+    rollout = "%s"
+  }
+}`
@@ -0,0 +1,89 @@
+package database
+
+import (
+	"context"
+	"log"
+
+	goApiAbrha "github.com/abrhacom/go-api-abrha"
+	"github.com/abrhacom/terraform-provider-abrha/abrha/config"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/diag"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/schema"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/validation"
+)
+
+// databaseMetricsCredentialsID is the ID of the single set of metrics
+// credentials shared by all database clusters on an account.
+const databaseMetricsCredentialsID = "metrics-credentials"
+
+func ResourceAbrhaDatabaseMetricsCredentials() *schema.Resource {
+	return &schema.Resource{
+		CreateContext: resourceAbrhaDatabaseMetricsCredentialsUpdate,
+		ReadContext:   resourceAbrhaDatabaseMetricsCredentialsRead,
+		UpdateContext: resourceAbrhaDatabaseMetricsCredentialsUpdate,
+		DeleteContext: resourceAbrhaDatabaseMetricsCredentialsDelete,
+		Importer: &schema.ResourceImporter{
+			StateContext: schema.ImportStatePassthroughContext,
+		},
+
+		Schema: map[string]*schema.Schema{
+			"username": {
+				Type:         schema.TypeString,
+				Required:     true,
+				ValidateFunc: validation.NoZeroValues,
+				Description:  "The basic auth username for the metrics endpoints of all database clusters on the account",
+			},
+
+			"password": {
+				Type:         schema.TypeString,
+				Required:     true,
+				Sensitive:    true,
+				ValidateFunc: validation.NoZeroValues,
+				Description:  "The basic auth password for the metrics endpoints of all database clusters on the account",
+			},
+		},
+	}
+}
+
+func resourceAbrhaDatabaseMetricsCredentialsUpdate(ctx context.Context, d *schema.ResourceData, meta interface{}) diag.Diagnostics {
+	client := meta.(*config.CombinedConfig).GoApiAbrhaClient()
+
+	opts := &goApiAbrha.DatabaseUpdateMetricsCredentialsRequest{
+		Credentials: &goApiAbrha.DatabaseMetricsCredentials{
+			BasicAuthUsername: d.Get("username").(string),
+			BasicAuthPassword: d.Get("password").(string),
+		},
+	}
+
+	log.Printf("[INFO] Updating database metrics credentials")
+	_, err := client.Databases.UpdateMetricsCredentials(ctx, opts)
+	if err != nil {
+		return diag.Errorf("Error updating database metrics credentials: %s", err)
+	}
+
+	d.SetId(databaseMetricsCredentialsID)
+
+	return resourceAbrhaDatabaseMetricsCredentialsRead(ctx, d, meta)
+}
+
+func resourceAbrhaDatabaseMetricsCredentialsRead(ctx context.Context, d *schema.ResourceData, meta interface{}) diag.Diagnostics {
+	client := meta.(*config.CombinedConfig).GoApiAbrhaClient()
+
+	creds, _, err := client.Databases.GetMetricsCredentials(ctx)
+	if err != nil {
+		return diag.Errorf("Error retrieving database metrics credentials: %s", err)
+	}
+
+	d.SetId(databaseMetricsCredentialsID)
+	d.Set("username", creds.BasicAuthUsername)
+	d.Set("password", creds.BasicAuthPassword)
+
+	return nil
+}
+
+func resourceAbrhaDatabaseMetricsCredentialsDelete(ctx context.Context, d *schema.ResourceData, meta interface{}) diag.Diagnostics {
+	// Metrics credentials always exist for an account and cannot be removed;
+	// deleting the resource only stops managing them.
+	log.Printf("[WARN] Database metrics credentials cannot be deleted, removing from state only")
+	d.SetId("")
+	return nil
+}
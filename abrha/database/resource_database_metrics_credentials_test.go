@@ -0,0 +1,52 @@
+package database_test
+
+import (
+	"fmt"
+	"testing"
+
+	"github.com/abrhacom/terraform-provider-abrha/abrha/acceptance"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/acctest"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/resource"
+)
+
+// Metrics credentials are shared by the whole account, so this test must not
+// run in parallel with itself.
+func TestAccAbrhaDatabaseMetricsCredentials_Basic(t *testing.T) {
+	username := acceptance.RandomTestName()
+	password := acctest.RandString(24)
+	updatedPassword := acctest.RandString(24)
+
+	resource.Test(t, resource.TestCase{
+		PreCheck:          func() { acceptance.TestAccPreCheck(t) },
+		ProviderFactories: acceptance.TestAccProviderFactories,
+		Steps: []resource.TestStep{
+			{
+				Config: fmt.Sprintf(testAccCheckAbrhaDatabaseMetricsCredentialsConfig, username, password),
+				Check: resource.ComposeTestCheckFunc(
+					resource.TestCheckResourceAttr(
+						"abrha_database_metrics_credentials.foobar", "username", username),
+					resource.TestCheckResourceAttr(
+						"abrha_database_metrics_credentials.foobar", "password", password),
+				),
+			},
+			{
+				Config: fmt.Sprintf(testAccCheckAbrhaDatabaseMetricsCredentialsConfig, username, updatedPassword),
+				Check: resource.ComposeTestCheckFunc(
+					resource.TestCheckResourceAttr(
+						"abrha_database_metrics_credentials.foobar", "password", updatedPassword),
+				),
+			},
+			{
+				ResourceName:      "abrha_database_metrics_credentials.foobar",
+				ImportState:       true,
+				ImportStateVerify: true,
+			},
+		},
+	})
+}
+
+const testAccCheckAbrhaDatabaseMetricsCredentialsConfig = `
+resource "abrha_database_metrics_credentials" "foobar" {
+  username = "%s"
+  password = "%s"
+}`
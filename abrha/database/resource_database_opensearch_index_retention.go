@@ -0,0 +1,202 @@
+package database
+
+import (
+	"context"
+	"fmt"
+	"log"
+	"path"
+	"sort"
+	"strings"
+	"time"
+
+	goApiAbrha "github.com/abrhacom/go-api-abrha"
+	"github.com/abrhacom/terraform-provider-abrha/abrha/config"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/diag"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/schema"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/validation"
+)
+
+func ResourceAbrhaDatabaseOpensearchIndexRetention() *schema.Resource {
+	return &schema.Resource{
+		CreateContext: resourceAbrhaDatabaseOpensearchIndexRetentionCreate,
+		ReadContext:   resourceAbrhaDatabaseOpensearchIndexRetentionRead,
+		UpdateContext: resourceAbrhaDatabaseOpensearchIndexRetentionUpdate,
+		DeleteContext: resourceAbrhaDatabaseOpensearchIndexRetentionDelete,
+
+		Schema: map[string]*schema.Schema{
+			"cluster_id": {
+				Type:         schema.TypeString,
+				Required:     true,
+				ForceNew:     true,
+				ValidateFunc: validation.NoZeroValues,
+				Description:  "The ID of the OpenSearch database cluster",
+			},
+
+			"index_pattern": {
+				Type:         schema.TypeString,
+				Required:     true,
+				ForceNew:     true,
+				ValidateFunc: validateIndexPattern,
+				Description:  "A glob pattern, such as `logs-*`, matching the names of indexes to delete. Hidden indexes starting with a dot only match patterns that start with a dot.",
+			},
+
+			"retention_days": {
+				Type:         schema.TypeInt,
+				Required:     true,
+				ValidateFunc: validation.IntAtLeast(1),
+				Description:  "The number of days matching indexes are kept before being deleted",
+			},
+
+			"expired_indexes": {
+				Type:        schema.TypeList,
+				Computed:    true,
+				Elem:        &schema.Schema{Type: schema.TypeString},
+				Description: "Matching indexes older than the retention period, which are deleted on the next apply",
+			},
+
+			"deleted_indexes": {
+				Type:        schema.TypeList,
+				Computed:    true,
+				Elem:        &schema.Schema{Type: schema.TypeString},
+				Description: "The indexes deleted by the last apply",
+			},
+		},
+
+		CustomizeDiff: planExpiredIndexDeletion(),
+	}
+}
+
+// planExpiredIndexDeletion plans the deletion of indexes that expired since
+// the last apply, as indexes age without any change to the configuration.
+func planExpiredIndexDeletion() schema.CustomizeDiffFunc {
+	return schema.CustomizeDiffFunc(func(ctx context.Context, diff *schema.ResourceDiff, v interface{}) error {
+		if diff.Id() == "" {
+			return nil
+		}
+
+		if expired := diff.Get("expired_indexes").([]interface{}); len(expired) > 0 {
+			if err := diff.SetNew("expired_indexes", []string{}); err != nil {
+				return err
+			}
+
+			return diff.SetNewComputed("deleted_indexes")
+		}
+
+		return nil
+	})
+}
+
+func validateIndexPattern(v interface{}, k string) (ws []string, errors []error) {
+	pattern := v.(string)
+	if pattern == "" {
+		errors = append(errors, fmt.Errorf("%q must not be empty", k))
+		return
+	}
+
+	if _, err := path.Match(pattern, ""); err != nil {
+		errors = append(errors, fmt.Errorf("%q is not a valid pattern: %s", k, err))
+	}
+
+	return
+}
+
+func resourceAbrhaDatabaseOpensearchIndexRetentionCreate(ctx context.Context, d *schema.ResourceData, meta interface{}) diag.Diagnostics {
+	clusterID := d.Get("cluster_id").(string)
+	d.SetId(fmt.Sprintf("%s/index-retention/%s", clusterID, d.Get("index_pattern").(string)))
+
+	return resourceAbrhaDatabaseOpensearchIndexRetentionUpdate(ctx, d, meta)
+}
+
+func resourceAbrhaDatabaseOpensearchIndexRetentionUpdate(ctx context.Context, d *schema.ResourceData, meta interface{}) diag.Diagnostics {
+	client := meta.(*config.CombinedConfig).GoApiAbrhaClient()
+	clusterID := d.Get("cluster_id").(string)
+
+	expired, _, err := expiredDatabaseIndexes(ctx, client, clusterID, d.Get("index_pattern").(string), d.Get("retention_days").(int))
+	if err != nil {
+		return diag.FromErr(err)
+	}
+
+	deleted := make([]string, 0, len(expired))
+	for _, name := range expired {
+		log.Printf("[INFO] Deleting expired index (%s) from database cluster (%s)", name, clusterID)
+		resp, err := client.Databases.DeleteIndex(ctx, clusterID, name)
+		if err != nil {
+			if resp != nil && resp.StatusCode == 404 {
+				continue
+			}
+
+			return diag.Errorf("Error deleting index (%s) from database cluster (%s): %s", name, clusterID, err)
+		}
+
+		deleted = append(deleted, name)
+	}
+
+	d.Set("deleted_indexes", deleted)
+
+	return resourceAbrhaDatabaseOpensearchIndexRetentionRead(ctx, d, meta)
+}
+
+func resourceAbrhaDatabaseOpensearchIndexRetentionRead(ctx context.Context, d *schema.ResourceData, meta interface{}) diag.Diagnostics {
+	client := meta.(*config.CombinedConfig).GoApiAbrhaClient()
+	clusterID := d.Get("cluster_id").(string)
+
+	expired, resp, err := expiredDatabaseIndexes(ctx, client, clusterID, d.Get("index_pattern").(string), d.Get("retention_days").(int))
+	if err != nil {
+		if resp != nil && resp.StatusCode == 404 {
+			log.Printf("[WARN] Database cluster (%s) not found, removing index retention from state", clusterID)
+			d.SetId("")
+			return nil
+		}
+
+		return diag.FromErr(err)
+	}
+
+	d.Set("expired_indexes", expired)
+
+	return nil
+}
+
+func resourceAbrhaDatabaseOpensearchIndexRetentionDelete(ctx context.Context, d *schema.ResourceData, meta interface{}) diag.Diagnostics {
+	// Removing the retention policy only stops deleting indexes.
+	d.SetId("")
+	return nil
+}
+
+// expiredDatabaseIndexes returns the names of the indexes of a cluster that
+// match pattern and were created more than retentionDays ago. Indexes with an
+// unknown creation time are never considered expired.
+func expiredDatabaseIndexes(ctx context.Context, client *goApiAbrha.Client, clusterID, pattern string, retentionDays int) ([]string, *goApiAbrha.Response, error) {
+	indexes, resp, err := listDatabaseIndexes(ctx, client, clusterID)
+	if err != nil {
+		return nil, resp, err
+	}
+
+	return filterExpiredIndexes(indexes, pattern, time.Now().AddDate(0, 0, -retentionDays)), resp, nil
+}
+
+func filterExpiredIndexes(indexes []goApiAbrha.DatabaseIndex, pattern string, cutoff time.Time) []string {
+	expired := make([]string, 0)
+	for _, index := range indexes {
+		if strings.HasPrefix(index.IndexName, ".") && !strings.HasPrefix(pattern, ".") {
+			continue
+		}
+
+		if matched, _ := path.Match(pattern, index.IndexName); !matched {
+			continue
+		}
+
+		createdAt, err := time.Parse(time.RFC3339, index.CreateTime)
+		if err != nil {
+			log.Printf("[WARN] Unable to parse creation time %q of index (%s), skipping", index.CreateTime, index.IndexName)
+			continue
+		}
+
+		if createdAt.Before(cutoff) {
+			expired = append(expired, index.IndexName)
+		}
+	}
+
+	sort.Strings(expired)
+
+	return expired
+}
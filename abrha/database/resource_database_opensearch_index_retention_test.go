@@ -0,0 +1,51 @@
+package database_test
+
+import (
+	"fmt"
+	"testing"
+
+	"github.com/abrhacom/terraform-provider-abrha/abrha/acceptance"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/resource"
+)
+
+func TestAccAbrhaDatabaseOpensearchIndexRetention_Basic(t *testing.T) {
+	name := acceptance.RandomTestName()
+	dbConfig := fmt.Sprintf(testAccCheckAbrhaDatabaseClusterOpensearch, name, "2")
+
+	resource.ParallelTest(t, resource.TestCase{
+		PreCheck:          func() { acceptance.TestAccPreCheck(t) },
+		ProviderFactories: acceptance.TestAccProviderFactories,
+		CheckDestroy:      testAccCheckAbrhaDatabaseClusterDestroy,
+		Steps: []resource.TestStep{
+			{
+				Config: fmt.Sprintf(testAccCheckAbrhaDatabaseOpensearchIndexRetentionConfig, dbConfig, 30),
+				Check: resource.ComposeTestCheckFunc(
+					resource.TestCheckResourceAttr(
+						"abrha_database_opensearch_index_retention.foobar", "index_pattern", "logs-*"),
+					resource.TestCheckResourceAttr(
+						"abrha_database_opensearch_index_retention.foobar", "retention_days", "30"),
+					resource.TestCheckResourceAttr(
+						"abrha_database_opensearch_index_retention.foobar", "expired_indexes.#", "0"),
+					resource.TestCheckResourceAttr(
+						"abrha_database_opensearch_index_retention.foobar", "deleted_indexes.#", "0"),
+				),
+			},
+			{
+				Config: fmt.Sprintf(testAccCheckAbrhaDatabaseOpensearchIndexRetentionConfig, dbConfig, 7),
+				Check: resource.ComposeTestCheckFunc(
+					resource.TestCheckResourceAttr(
+						"abrha_database_opensearch_index_retention.foobar", "retention_days", "7"),
+				),
+			},
+		},
+	})
+}
+
+const testAccCheckAbrhaDatabaseOpensearchIndexRetentionConfig = `
+%s
+
+resource "abrha_database_opensearch_index_retention" "foobar" {
+  cluster_id     = abrha_database_cluster.foobar.id
+  index_pattern  = "logs-*"
+  retention_days = %d
+}`
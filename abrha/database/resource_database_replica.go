@@ -129,7 +129,21 @@ func ResourceAbrhaDatabaseReplica() *schema.Resource {
 				Optional: true,
 				Computed: true,
 			},
+
+			"promote": {
+				Type:        schema.TypeBool,
+				Optional:    true,
+				Default:     false,
+				Description: "Whether to promote the replica to a standalone database cluster. The promoted cluster is then managed by this resource and destroyed along with it. A promoted replica cannot be demoted.",
+			},
 		},
+
+		Timeouts: &schema.ResourceTimeout{
+			Create: schema.DefaultTimeout(30 * time.Minute),
+			Update: schema.DefaultTimeout(30 * time.Minute),
+		},
+
+		CustomizeDiff: validateDatabaseReplicaPromotion(),
 	}
 }
 
@@ -200,6 +214,12 @@ func resourceAbrhaDatabaseReplicaCreate(ctx context.Context, d *schema.ResourceD
 	d.Set("uuid", replica.ID)
 	log.Printf("[INFO] DatabaseReplica Name: %s", replica.Name)
 
+	if d.Get("promote").(bool) {
+		if err := promoteDatabaseReplica(ctx, client, d, d.Timeout(schema.TimeoutCreate)); err != nil {
+			return diag.FromErr(err)
+		}
+	}
+
 	return resourceAbrhaDatabaseReplicaRead(ctx, d, meta)
 }
 
@@ -207,11 +227,27 @@ func resourceAbrhaDatabaseReplicaRead(ctx context.Context, d *schema.ResourceDat
 	client := meta.(*config.CombinedConfig).GoApiAbrhaClient()
 	clusterId := d.Get("cluster_id").(string)
 	name := d.Get("name").(string)
+
+	// The data source shares this function but has no promote argument.
+	if promoted, _ := d.Get("promote").(bool); promoted {
+		return resourceAbrhaDatabaseReplicaReadPromoted(ctx, d, meta)
+	}
+
 	replica, resp, err := client.Databases.GetReplica(context.Background(), clusterId, name)
 	if err != nil {
 		// If the database is somehow already destroyed, mark as
 		// successfully gone
 		if resp != nil && resp.StatusCode == 404 {
+			// A replica promoted outside of Terraform keeps its ID as a
+			// standalone cluster, so keep managing that cluster instead of
+			// creating a new replica.
+			if replicaID := d.Get("uuid").(string); replicaID != "" {
+				if _, _, err := client.Databases.Get(ctx, replicaID); err == nil {
+					log.Printf("[WARN] DatabaseReplica (%s) has been promoted to the standalone database cluster (%s)", name, replicaID)
+					return resourceAbrhaDatabaseReplicaReadPromoted(ctx, d, meta)
+				}
+			}
+
 			d.SetId("")
 			return nil
 		}
@@ -236,6 +272,26 @@ func resourceAbrhaDatabaseReplicaRead(ctx context.Context, d *schema.ResourceDat
 	return nil
 }
 
+func resourceAbrhaDatabaseReplicaReadPromoted(ctx context.Context, d *schema.ResourceData, meta interface{}) diag.Diagnostics {
+	client := meta.(*config.CombinedConfig).GoApiAbrhaClient()
+
+	database, resp, err := client.Databases.Get(ctx, d.Get("uuid").(string))
+	if err != nil {
+		if resp != nil && resp.StatusCode == 404 {
+			d.SetId("")
+			return nil
+		}
+
+		return diag.Errorf("Error retrieving promoted database cluster: %s", err)
+	}
+
+	if err := setPromotedDatabaseReplicaAttributes(database, d); err != nil {
+		return diag.Errorf("Error building connection URI: %s", err)
+	}
+
+	return nil
+}
+
 func setReplicaConnectionInfo(replica *goApiAbrha.DatabaseReplica, d *schema.ResourceData) error {
 	if replica.Connection != nil {
 		d.Set("host", replica.Connection.Host)
@@ -298,12 +354,22 @@ func resourceAbrhaDatabaseReplicaUpdate(ctx context.Context, d *schema.ResourceD
 			return diag.Errorf("Error resizing database replica: %s", err)
 		}
 
-		_, err = waitForDatabaseReplica(client, clusterID, "online", replicaName)
+		if promoted, _ := d.GetChange("promote"); promoted.(bool) {
+			err = waitForDatabaseClusterOnline(ctx, client, replicaID, d.Timeout(schema.TimeoutUpdate))
+		} else {
+			_, err = waitForDatabaseReplica(client, clusterID, "online", replicaName)
+		}
 		if err != nil {
 			return diag.Errorf("Error resizing database replica: %s", err)
 		}
 	}
 
+	if d.HasChange("promote") && d.Get("promote").(bool) {
+		if err := promoteDatabaseReplica(ctx, client, d, d.Timeout(schema.TimeoutUpdate)); err != nil {
+			return diag.FromErr(err)
+		}
+	}
+
 	return resourceAbrhaDatabaseReplicaRead(ctx, d, meta)
 }
 
@@ -325,6 +391,17 @@ func resourceAbrhaDatabaseReplicaDelete(ctx context.Context, d *schema.ResourceD
 	clusterId := d.Get("cluster_id").(string)
 	name := d.Get("name").(string)
 
+	if d.Get("promote").(bool) {
+		log.Printf("[INFO] Deleting promoted database cluster: %s", d.Get("uuid").(string))
+		resp, err := client.Databases.Delete(ctx, d.Get("uuid").(string))
+		if err != nil && (resp == nil || resp.StatusCode != 404) {
+			return diag.Errorf("Error deleting promoted database cluster: %s", err)
+		}
+
+		d.SetId("")
+		return nil
+	}
+
 	log.Printf("[INFO] Deleting DatabaseReplica: %s", d.Id())
 	_, err := client.Databases.DeleteReplica(context.Background(), clusterId, name)
 	if err != nil {
@@ -3,6 +3,7 @@ package database_test
 import (
 	"context"
 	"fmt"
+	"regexp"
 	"testing"
 
 	goApiAbrha "github.com/abrhacom/go-api-abrha"
@@ -161,6 +162,81 @@ func TestAccAbrhaDatabaseReplica_Resize(t *testing.T) {
 	})
 }
 
+func TestAccAbrhaDatabaseReplica_Promote(t *testing.T) {
+	var databaseReplica goApiAbrha.DatabaseReplica
+	var database goApiAbrha.Database
+
+	databaseName := acceptance.RandomTestName()
+	databaseReplicaName := acceptance.RandomTestName()
+
+	databaseConfig := fmt.Sprintf(testAccCheckAbrhaDatabaseClusterConfigBasic, databaseName)
+	replicaConfig := fmt.Sprintf(testAccCheckAbrhaDatabaseReplicaConfigBasic, databaseReplicaName)
+	promotedConfig := fmt.Sprintf(testAccCheckAbrhaDatabaseReplicaConfigPromoted, databaseReplicaName, true)
+	demotedConfig := fmt.Sprintf(testAccCheckAbrhaDatabaseReplicaConfigPromoted, databaseReplicaName, false)
+
+	resource.ParallelTest(t, resource.TestCase{
+		PreCheck:          func() { acceptance.TestAccPreCheck(t) },
+		ProviderFactories: acceptance.TestAccProviderFactories,
+		CheckDestroy:      testAccCheckAbrhaDatabaseReplicaDestroy,
+		Steps: []resource.TestStep{
+			{
+				Config: databaseConfig + replicaConfig,
+				Check: resource.ComposeTestCheckFunc(
+					testAccCheckAbrhaDatabaseReplicaExists("abrha_database_replica.read-01", &databaseReplica),
+					resource.TestCheckResourceAttr(
+						"abrha_database_replica.read-01", "promote", "false"),
+				),
+			},
+			{
+				Config: databaseConfig + promotedConfig,
+				Check: resource.ComposeTestCheckFunc(
+					testAccCheckAbrhaDatabaseReplicaPromoted("abrha_database_replica.read-01", &database),
+					resource.TestCheckResourceAttr(
+						"abrha_database_replica.read-01", "promote", "true"),
+					resource.TestCheckResourceAttr(
+						"abrha_database_replica.read-01", "name", databaseReplicaName),
+					resource.TestCheckResourceAttrSet(
+						"abrha_database_replica.read-01", "host"),
+					resource.TestCheckResourceAttrSet(
+						"abrha_database_replica.read-01", "private_host"),
+					resource.TestCheckResourceAttrSet(
+						"abrha_database_replica.read-01", "uri"),
+					resource.TestCheckResourceAttrSet(
+						"abrha_database_replica.read-01", "password"),
+				),
+			},
+			{
+				Config:      databaseConfig + demotedConfig,
+				ExpectError: regexp.MustCompile("cannot be demoted"),
+			},
+		},
+	})
+}
+
+func testAccCheckAbrhaDatabaseReplicaPromoted(n string, database *goApiAbrha.Database) resource.TestCheckFunc {
+	return func(s *terraform.State) error {
+		rs, ok := s.RootModule().Resources[n]
+		if !ok {
+			return fmt.Errorf("Not found: %s", n)
+		}
+
+		client := acceptance.TestAccProvider.Meta().(*config.CombinedConfig).GoApiAbrhaClient()
+
+		foundDatabase, _, err := client.Databases.Get(context.Background(), rs.Primary.Attributes["uuid"])
+		if err != nil {
+			return fmt.Errorf("Promoted DatabaseReplica cluster not found: %s", err)
+		}
+
+		if _, _, err := client.Databases.GetReplica(context.Background(), rs.Primary.Attributes["cluster_id"], rs.Primary.Attributes["name"]); err == nil {
+			return fmt.Errorf("DatabaseReplica is still a replica of its cluster")
+		}
+
+		*database = *foundDatabase
+
+		return nil
+	}
+}
+
 func testAccCheckAbrhaDatabaseReplicaDestroy(s *terraform.State) error {
 	client := acceptance.TestAccProvider.Meta().(*config.CombinedConfig).GoApiAbrhaClient()
 
@@ -168,6 +244,15 @@ func testAccCheckAbrhaDatabaseReplicaDestroy(s *terraform.State) error {
 		if rs.Type != "abrha_database_replica" {
 			continue
 		}
+		// A promoted replica is destroyed as a standalone cluster
+		if rs.Primary.Attributes["promote"] == "true" {
+			_, _, err := client.Databases.Get(context.Background(), rs.Primary.Attributes["uuid"])
+			if err == nil {
+				return fmt.Errorf("Promoted DatabaseReplica cluster still exists")
+			}
+			continue
+		}
+
 		clusterId := rs.Primary.Attributes["cluster_id"]
 		name := rs.Primary.Attributes["name"]
 		// Try to find the database replica
@@ -238,6 +323,16 @@ resource "abrha_database_replica" "read-01" {
   tags       = ["staging"]
 }`
 
+const testAccCheckAbrhaDatabaseReplicaConfigPromoted = `
+resource "abrha_database_replica" "read-01" {
+  cluster_id = abrha_database_cluster.foobar.id
+  name       = "%s"
+  region     = "nyc3"
+  size       = "db-s-1vcpu-2gb"
+  tags       = ["staging"]
+  promote    = %t
+}`
+
 const testAccCheckAbrhaDatabaseReplicaConfigResized = `
 resource "abrha_database_replica" "read-01" {
   cluster_id       = abrha_database_cluster.foobar.id
@@ -6,6 +6,7 @@ import (
 	"fmt"
 	"log"
 	"strings"
+	"time"
 
 	goApiAbrha "github.com/abrhacom/go-api-abrha"
 	"github.com/abrhacom/terraform-provider-abrha/abrha/config"
@@ -89,7 +90,44 @@ func ResourceAbrhaDatabaseUser() *schema.Resource {
 				Computed:  true,
 				Sensitive: true,
 			},
+			"rotation_triggers": {
+				Type:        schema.TypeMap,
+				Optional:    true,
+				Elem:        &schema.Schema{Type: schema.TypeString},
+				Description: "Arbitrary map of values that, when changed, will regenerate the user's password in place",
+			},
+			"password_rotation_days": {
+				Type:         schema.TypeInt,
+				Optional:     true,
+				ValidateFunc: validation.IntAtLeast(1),
+				Description:  "The number of days after which the password is regenerated during the next apply",
+			},
+			"previous_password_grace_period_hours": {
+				Type:         schema.TypeInt,
+				Optional:     true,
+				Default:      24,
+				ValidateFunc: validation.IntAtLeast(0),
+				Description:  "The number of hours the previous password is kept in state after a rotation",
+			},
+			"password_rotated_at": {
+				Type:        schema.TypeString,
+				Computed:    true,
+				Description: "The date and time the password was created or last rotated by Terraform",
+			},
+			"previous_password": {
+				Type:        schema.TypeString,
+				Computed:    true,
+				Sensitive:   true,
+				Description: "The password before the last rotation, kept until the grace period ends. The cluster no longer accepts it.",
+			},
+			"previous_password_expires_at": {
+				Type:        schema.TypeString,
+				Computed:    true,
+				Description: "The date and time the previous password is removed from state",
+			},
 		},
+
+		CustomizeDiff: planDatabaseUserPasswordRotation(),
 	}
 }
 
@@ -180,6 +218,7 @@ func resourceAbrhaDatabaseUserCreate(ctx context.Context, d *schema.ResourceData
 	}
 
 	setDatabaseUserAttributes(d, user)
+	d.Set("password_rotated_at", time.Now().UTC().Format(time.RFC3339))
 
 	return nil
 }
@@ -203,6 +242,7 @@ func resourceAbrhaDatabaseUserRead(ctx context.Context, d *schema.ResourceData,
 	}
 
 	setDatabaseUserAttributes(d, user)
+	expirePreviousDatabaseUserPassword(d, time.Now())
 
 	return nil
 }
@@ -266,6 +306,13 @@ func resourceAbrhaDatabaseUserUpdate(ctx context.Context, d *schema.ResourceData
 		}
 	}
 
+	rotatedAt, _ := d.GetChange("password_rotated_at")
+	if d.HasChange("rotation_triggers") || passwordRotationDue(rotatedAt.(string), d.Get("password_rotation_days").(int), time.Now()) {
+		if err := rotateDatabaseUserPassword(ctx, client, d); err != nil {
+			return diag.FromErr(err)
+		}
+	}
+
 	return resourceAbrhaDatabaseUserRead(ctx, d, meta)
 }
 
@@ -319,6 +319,54 @@ func TestAccAbrhaDatabaseUser_OpenSearchACLs(t *testing.T) {
 	})
 }
 
+func TestAccAbrhaDatabaseUser_PasswordRotation(t *testing.T) {
+	var databaseUser goApiAbrha.DatabaseUser
+	var originalPassword string
+	databaseClusterName := acceptance.RandomTestName()
+	databaseUserName := acceptance.RandomTestName()
+
+	resource.ParallelTest(t, resource.TestCase{
+		PreCheck:          func() { acceptance.TestAccPreCheck(t) },
+		ProviderFactories: acceptance.TestAccProviderFactories,
+		CheckDestroy:      testAccCheckAbrhaDatabaseUserDestroy,
+		Steps: []resource.TestStep{
+			{
+				Config: fmt.Sprintf(testAccCheckAbrhaDatabaseUserConfigRotation, databaseClusterName, databaseUserName, "1"),
+				Check: resource.ComposeTestCheckFunc(
+					testAccCheckAbrhaDatabaseUserExists("abrha_database_user.foobar_user", &databaseUser),
+					resource.TestCheckResourceAttrSet(
+						"abrha_database_user.foobar_user", "password_rotated_at"),
+					resource.TestCheckResourceAttr(
+						"abrha_database_user.foobar_user", "previous_password", ""),
+					func(s *terraform.State) error {
+						originalPassword = s.RootModule().Resources["abrha_database_user.foobar_user"].Primary.Attributes["password"]
+						return nil
+					},
+				),
+			},
+			{
+				Config: fmt.Sprintf(testAccCheckAbrhaDatabaseUserConfigRotation, databaseClusterName, databaseUserName, "2"),
+				Check: resource.ComposeTestCheckFunc(
+					testAccCheckAbrhaDatabaseUserExists("abrha_database_user.foobar_user", &databaseUser),
+					testAccCheckAbrhaDatabaseUserAttributes(&databaseUser, databaseUserName),
+					resource.TestCheckResourceAttrSet(
+						"abrha_database_user.foobar_user", "previous_password_expires_at"),
+					func(s *terraform.State) error {
+						attrs := s.RootModule().Resources["abrha_database_user.foobar_user"].Primary.Attributes
+						if attrs["password"] == originalPassword {
+							return fmt.Errorf("expected password to be rotated")
+						}
+						if attrs["previous_password"] != originalPassword {
+							return fmt.Errorf("expected previous_password to hold the password before rotation")
+						}
+						return nil
+					},
+				),
+			},
+		},
+	})
+}
+
 func testAccCheckAbrhaDatabaseUserDestroy(s *terraform.State) error {
 	client := acceptance.TestAccProvider.Meta().(*config.CombinedConfig).GoApiAbrhaClient()
 
@@ -641,3 +689,22 @@ resource "abrha_database_user" "foobar_user" {
   cluster_id = abrha_database_cluster.foobar.id
   name       = "%s"
 }`
+
+const testAccCheckAbrhaDatabaseUserConfigRotation = `
+resource "abrha_database_cluster" "foobar" {
+  name       = "%s"
+  engine     = "pg"
+  version    = "15"
+  size       = "db-s-1vcpu-1gb"
+  region     = "nyc1"
+  node_count = 1
+}
+
+resource "abrha_database_user" "foobar_user" {
+  cluster_id = abrha_database_cluster.foobar.id
+  name       = "%s"
+
+  rotation_triggers = {
+    rotation = "%s"
+  }
+}`
@@ -0,0 +1,17 @@
+package firewall
+
+import (
+	"github.com/abrhacom/terraform-provider-abrha/internal/datalist"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/schema"
+)
+
+func DataSourceAbrhaFirewalls() *schema.Resource {
+	dataListConfig := &datalist.ResourceConfig{
+		RecordSchema:        firewallListSchema(),
+		ResultAttributeName: "firewalls",
+		GetRecords:          getAbrhaFirewalls,
+		FlattenRecord:       flattenAbrhaFirewall,
+	}
+
+	return datalist.NewResource(dataListConfig)
+}
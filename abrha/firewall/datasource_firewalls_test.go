@@ -0,0 +1,44 @@
+package firewall_test
+
+import (
+	"fmt"
+	"testing"
+
+	"github.com/abrhacom/terraform-provider-abrha/abrha/acceptance"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/resource"
+)
+
+func TestAccDataSourceAbrhaFirewalls_Basic(t *testing.T) {
+	fwName := acceptance.RandomTestName()
+	resourceConfig := testAccAbrhaFirewallConfig_OnlyInbound(fwName)
+	datasourceConfig := fmt.Sprintf(`
+data "abrha_firewalls" "result" {
+  filter {
+    key    = "name"
+    values = ["%s"]
+  }
+}
+`, fwName)
+
+	resource.ParallelTest(t, resource.TestCase{
+		PreCheck:          func() { acceptance.TestAccPreCheck(t) },
+		ProviderFactories: acceptance.TestAccProviderFactories,
+		Steps: []resource.TestStep{
+			{
+				Config: resourceConfig,
+			},
+			{
+				Config: resourceConfig + datasourceConfig,
+				Check: resource.ComposeTestCheckFunc(
+					resource.TestCheckResourceAttr("data.abrha_firewalls.result", "firewalls.#", "1"),
+					resource.TestCheckResourceAttr("data.abrha_firewalls.result", "firewalls.0.name", fwName),
+					resource.TestCheckResourceAttrPair("data.abrha_firewalls.result", "firewalls.0.id", "abrha_firewall.foobar", "id"),
+					resource.TestCheckResourceAttrPair("data.abrha_firewalls.result", "firewalls.0.status", "abrha_firewall.foobar", "status"),
+				),
+			},
+			{
+				Config: resourceConfig,
+			},
+		},
+	})
+}
@@ -1,7 +1,11 @@
 package firewall
 
 import (
+	"context"
+	"fmt"
+
 	goApiAbrha "github.com/abrhacom/go-api-abrha"
+	"github.com/abrhacom/terraform-provider-abrha/abrha/config"
 	"github.com/abrhacom/terraform-provider-abrha/abrha/tag"
 	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/schema"
 	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/validation"
@@ -335,3 +339,85 @@ func flattenFirewallOutboundRules(rules []goApiAbrha.OutboundRule) []interface{}
 
 	return flattenedRules
 }
+
+func firewallListSchema() map[string]*schema.Schema {
+	return map[string]*schema.Schema{
+		"id": {
+			Type:        schema.TypeString,
+			Description: "id of the firewall",
+		},
+		"name": {
+			Type:        schema.TypeString,
+			Description: "name of the firewall",
+		},
+		"status": {
+			Type:        schema.TypeString,
+			Description: "the status of the firewall",
+		},
+		"created_at": {
+			Type:        schema.TypeString,
+			Description: "the creation date of the firewall",
+		},
+		"vm_ids": {
+			Type:        schema.TypeSet,
+			Elem:        &schema.Schema{Type: schema.TypeString},
+			Description: "ids of the vms the firewall is applied to",
+		},
+		"tags": tag.TagsDataSourceSchema(),
+	}
+}
+
+func getAbrhaFirewalls(meta interface{}, extra map[string]interface{}) ([]interface{}, error) {
+	client := meta.(*config.CombinedConfig).GoApiAbrhaClient()
+
+	opts := &goApiAbrha.ListOptions{
+		Page:    1,
+		PerPage: 200,
+	}
+
+	var firewallList []interface{}
+
+	for {
+		firewalls, resp, err := client.Firewalls.List(context.Background(), opts)
+		if err != nil {
+			return nil, fmt.Errorf("Error retrieving firewalls: %s", err)
+		}
+
+		for _, firewall := range firewalls {
+			firewallList = append(firewallList, firewall)
+		}
+
+		if resp.Links == nil || resp.Links.IsLastPage() {
+			break
+		}
+
+		page, err := resp.Links.CurrentPage()
+		if err != nil {
+			return nil, fmt.Errorf("Error retrieving firewalls: %s", err)
+		}
+
+		opts.Page = page + 1
+	}
+
+	return firewallList, nil
+}
+
+func flattenAbrhaFirewall(rawFirewall, meta interface{}, extra map[string]interface{}) (map[string]interface{}, error) {
+	firewall := rawFirewall.(goApiAbrha.Firewall)
+
+	vmIDs := schema.NewSet(schema.HashString, []interface{}{})
+	for _, id := range firewall.VmIDs {
+		vmIDs.Add(id)
+	}
+
+	flattenedFirewall := map[string]interface{}{
+		"id":         firewall.ID,
+		"name":       firewall.Name,
+		"status":     firewall.Status,
+		"created_at": firewall.Created,
+		"vm_ids":     vmIDs,
+		"tags":       tag.FlattenTags(firewall.Tags),
+	}
+
+	return flattenedFirewall, nil
+}
@@ -0,0 +1,106 @@
+package function
+
+import (
+	"context"
+	"fmt"
+
+	goApiAbrha "github.com/abrhacom/go-api-abrha"
+	"github.com/abrhacom/terraform-provider-abrha/abrha/config"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/diag"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/schema"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/validation"
+)
+
+func DataSourceAbrhaFunctionNamespace() *schema.Resource {
+	return &schema.Resource{
+		ReadContext: dataSourceAbrhaFunctionNamespaceRead,
+		Schema: map[string]*schema.Schema{
+			"id": {
+				Type:         schema.TypeString,
+				Optional:     true,
+				Computed:     true,
+				ValidateFunc: validation.NoZeroValues,
+				ExactlyOneOf: []string{"id", "label"},
+			},
+			"label": {
+				Type:         schema.TypeString,
+				Optional:     true,
+				Computed:     true,
+				ValidateFunc: validation.NoZeroValues,
+				ExactlyOneOf: []string{"id", "label"},
+			},
+			"region": {
+				Type:     schema.TypeString,
+				Computed: true,
+			},
+			"uuid": {
+				Type:     schema.TypeString,
+				Computed: true,
+			},
+			"api_host": {
+				Type:     schema.TypeString,
+				Computed: true,
+			},
+			"key": {
+				Type:      schema.TypeString,
+				Computed:  true,
+				Sensitive: true,
+			},
+			"created_at": {
+				Type:     schema.TypeString,
+				Computed: true,
+			},
+			"updated_at": {
+				Type:     schema.TypeString,
+				Computed: true,
+			},
+		},
+	}
+}
+
+func dataSourceAbrhaFunctionNamespaceRead(ctx context.Context, d *schema.ResourceData, meta interface{}) diag.Diagnostics {
+	client := meta.(*config.CombinedConfig).GoApiAbrhaClient()
+	var foundNamespace *goApiAbrha.FunctionsNamespace
+
+	if id, ok := d.GetOk("id"); ok {
+		namespace, _, err := client.Functions.GetNamespace(context.Background(), id.(string))
+		if err != nil {
+			return diag.Errorf("Error retrieving functions namespace: %s", err)
+		}
+
+		foundNamespace = namespace
+	} else if label, ok := d.GetOk("label"); ok {
+		namespaces, _, err := client.Functions.ListNamespaces(context.Background())
+		if err != nil {
+			return diag.Errorf("Error retrieving functions namespace: %s", err)
+		}
+
+		namespace, err := findFunctionNamespaceByLabel(namespaces, label.(string))
+		if err != nil {
+			return diag.Errorf("Error retrieving functions namespace: %s", err)
+		}
+
+		foundNamespace = namespace
+	}
+
+	d.SetId(foundNamespace.Namespace)
+	setFunctionNamespaceAttributes(d, foundNamespace)
+
+	return nil
+}
+
+func findFunctionNamespaceByLabel(namespaces []goApiAbrha.FunctionsNamespace, label string) (*goApiAbrha.FunctionsNamespace, error) {
+	results := make([]goApiAbrha.FunctionsNamespace, 0)
+	for _, n := range namespaces {
+		if n.Label == label {
+			results = append(results, n)
+		}
+	}
+	if len(results) == 1 {
+		return &results[0], nil
+	} else if len(results) == 0 {
+		return nil, fmt.Errorf("no functions namespace found with label %s", label)
+	}
+
+	return nil, fmt.Errorf("too many functions namespaces found with label %s (found %d, expected 1)", label, len(results))
+}
@@ -0,0 +1,41 @@
+package function_test
+
+import (
+	"fmt"
+	"testing"
+
+	"github.com/abrhacom/terraform-provider-abrha/abrha/acceptance"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/resource"
+)
+
+func TestAccDataSourceAbrhaFunctionNamespace_ByLabel(t *testing.T) {
+	namespaceLabel := acceptance.RandomTestName()
+	resourceConfig := fmt.Sprintf(testAccCheckAbrhaFunctionNamespaceConfig_Basic, namespaceLabel)
+	dataSourceConfig := `
+data "abrha_function_namespace" "foobar" {
+  label = abrha_function_namespace.foobar.label
+}`
+
+	resource.ParallelTest(t, resource.TestCase{
+		PreCheck:          func() { acceptance.TestAccPreCheck(t) },
+		ProviderFactories: acceptance.TestAccProviderFactories,
+		Steps: []resource.TestStep{
+			{
+				Config: resourceConfig,
+			},
+			{
+				Config: resourceConfig + dataSourceConfig,
+				Check: resource.ComposeTestCheckFunc(
+					resource.TestCheckResourceAttrPair(
+						"data.abrha_function_namespace.foobar", "id", "abrha_function_namespace.foobar", "id"),
+					resource.TestCheckResourceAttr(
+						"data.abrha_function_namespace.foobar", "label", namespaceLabel),
+					resource.TestCheckResourceAttr(
+						"data.abrha_function_namespace.foobar", "region", "nyc1"),
+					resource.TestCheckResourceAttrSet(
+						"data.abrha_function_namespace.foobar", "api_host"),
+				),
+			},
+		},
+	})
+}
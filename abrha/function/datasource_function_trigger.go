@@ -0,0 +1,100 @@
+package function
+
+import (
+	"context"
+
+	"github.com/abrhacom/terraform-provider-abrha/abrha/config"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/diag"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/schema"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/validation"
+)
+
+func DataSourceAbrhaFunctionTrigger() *schema.Resource {
+	return &schema.Resource{
+		ReadContext: dataSourceAbrhaFunctionTriggerRead,
+		Schema: map[string]*schema.Schema{
+			"namespace": {
+				Type:         schema.TypeString,
+				Required:     true,
+				Description:  "The ID of the functions namespace the trigger belongs to",
+				ValidateFunc: validation.NoZeroValues,
+			},
+			"name": {
+				Type:         schema.TypeString,
+				Required:     true,
+				Description:  "The name of the trigger",
+				ValidateFunc: validation.NoZeroValues,
+			},
+			"function": {
+				Type:     schema.TypeString,
+				Computed: true,
+			},
+			"type": {
+				Type:     schema.TypeString,
+				Computed: true,
+			},
+			"is_enabled": {
+				Type:     schema.TypeBool,
+				Computed: true,
+			},
+			"scheduled_details": {
+				Type:     schema.TypeList,
+				Computed: true,
+				Elem: &schema.Resource{
+					Schema: map[string]*schema.Schema{
+						"cron": {
+							Type:     schema.TypeString,
+							Computed: true,
+						},
+						"body": {
+							Type:     schema.TypeString,
+							Computed: true,
+						},
+					},
+				},
+			},
+			"scheduled_runs": {
+				Type:     schema.TypeList,
+				Computed: true,
+				Elem: &schema.Resource{
+					Schema: map[string]*schema.Schema{
+						"last_run_at": {
+							Type:     schema.TypeString,
+							Computed: true,
+						},
+						"next_run_at": {
+							Type:     schema.TypeString,
+							Computed: true,
+						},
+					},
+				},
+			},
+			"created_at": {
+				Type:     schema.TypeString,
+				Computed: true,
+			},
+			"updated_at": {
+				Type:     schema.TypeString,
+				Computed: true,
+			},
+		},
+	}
+}
+
+func dataSourceAbrhaFunctionTriggerRead(ctx context.Context, d *schema.ResourceData, meta interface{}) diag.Diagnostics {
+	client := meta.(*config.CombinedConfig).GoApiAbrhaClient()
+	namespace := d.Get("namespace").(string)
+	name := d.Get("name").(string)
+
+	trigger, _, err := client.Functions.GetTrigger(context.Background(), namespace, name)
+	if err != nil {
+		return diag.Errorf("Error retrieving functions trigger: %s", err)
+	}
+
+	d.SetId(makeFunctionTriggerID(namespace, trigger.Name))
+	if err := setFunctionTriggerAttributes(d, trigger); err != nil {
+		return diag.FromErr(err)
+	}
+
+	return nil
+}
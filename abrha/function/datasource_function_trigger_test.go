@@ -0,0 +1,43 @@
+package function_test
+
+import (
+	"fmt"
+	"testing"
+
+	"github.com/abrhacom/terraform-provider-abrha/abrha/acceptance"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/resource"
+)
+
+func TestAccDataSourceAbrhaFunctionTrigger_Basic(t *testing.T) {
+	namespaceLabel := acceptance.RandomTestName()
+	triggerName := acceptance.RandomTestName("trigger")
+	resourceConfig := fmt.Sprintf(testAccCheckAbrhaFunctionTriggerConfig_Basic, namespaceLabel, triggerName, true, "*/5 * * * *")
+	dataSourceConfig := `
+data "abrha_function_trigger" "foobar" {
+  namespace = abrha_function_trigger.foobar.namespace
+  name      = abrha_function_trigger.foobar.name
+}`
+
+	resource.ParallelTest(t, resource.TestCase{
+		PreCheck:          func() { acceptance.TestAccPreCheck(t) },
+		ProviderFactories: acceptance.TestAccProviderFactories,
+		Steps: []resource.TestStep{
+			{
+				Config: resourceConfig,
+			},
+			{
+				Config: resourceConfig + dataSourceConfig,
+				Check: resource.ComposeTestCheckFunc(
+					resource.TestCheckResourceAttr(
+						"data.abrha_function_trigger.foobar", "name", triggerName),
+					resource.TestCheckResourceAttr(
+						"data.abrha_function_trigger.foobar", "function", "sample/hello"),
+					resource.TestCheckResourceAttr(
+						"data.abrha_function_trigger.foobar", "is_enabled", "true"),
+					resource.TestCheckResourceAttr(
+						"data.abrha_function_trigger.foobar", "scheduled_details.0.cron", "*/5 * * * *"),
+				),
+			},
+		},
+	})
+}
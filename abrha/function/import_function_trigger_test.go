@@ -0,0 +1,56 @@
+package function_test
+
+import (
+	"fmt"
+	"regexp"
+	"testing"
+
+	"github.com/abrhacom/terraform-provider-abrha/abrha/acceptance"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/resource"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/terraform"
+)
+
+func TestAccAbrhaFunctionTrigger_importBasic(t *testing.T) {
+	resourceName := "abrha_function_trigger.foobar"
+	namespaceLabel := acceptance.RandomTestName()
+	triggerName := acceptance.RandomTestName("trigger")
+
+	resource.ParallelTest(t, resource.TestCase{
+		PreCheck:          func() { acceptance.TestAccPreCheck(t) },
+		ProviderFactories: acceptance.TestAccProviderFactories,
+		CheckDestroy:      testAccCheckAbrhaFunctionTriggerDestroy,
+		Steps: []resource.TestStep{
+			{
+				Config: fmt.Sprintf(testAccCheckAbrhaFunctionTriggerConfig_Basic, namespaceLabel, triggerName, true, "*/5 * * * *"),
+			},
+			{
+				ResourceName:      resourceName,
+				ImportState:       true,
+				ImportStateVerify: true,
+				// Requires passing both the namespace ID and trigger name
+				ImportStateIdFunc: testAccFunctionTriggerImportID(resourceName),
+			},
+			{
+				ResourceName:      resourceName,
+				ImportState:       true,
+				ImportStateVerify: false,
+				ImportStateId:     "trigger",
+				ExpectError:       regexp.MustCompile("joined with a comma"),
+			},
+		},
+	})
+}
+
+func testAccFunctionTriggerImportID(n string) resource.ImportStateIdFunc {
+	return func(s *terraform.State) (string, error) {
+		rs, ok := s.RootModule().Resources[n]
+		if !ok {
+			return "", fmt.Errorf("Not found: %s", n)
+		}
+
+		namespace := rs.Primary.Attributes["namespace"]
+		name := rs.Primary.Attributes["name"]
+
+		return fmt.Sprintf("%s,%s", namespace, name), nil
+	}
+}
@@ -0,0 +1,134 @@
+package function
+
+import (
+	"context"
+	"log"
+	"time"
+
+	goApiAbrha "github.com/abrhacom/go-api-abrha"
+	"github.com/abrhacom/terraform-provider-abrha/abrha/config"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/diag"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/schema"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/validation"
+)
+
+func ResourceAbrhaFunctionNamespace() *schema.Resource {
+	return &schema.Resource{
+		CreateContext: resourceAbrhaFunctionNamespaceCreate,
+		ReadContext:   resourceAbrhaFunctionNamespaceRead,
+		DeleteContext: resourceAbrhaFunctionNamespaceDelete,
+		Importer: &schema.ResourceImporter{
+			StateContext: schema.ImportStatePassthroughContext,
+		},
+
+		Schema: map[string]*schema.Schema{
+			"label": {
+				Type:         schema.TypeString,
+				Required:     true,
+				ForceNew:     true,
+				Description:  "The label of the functions namespace",
+				ValidateFunc: validation.NoZeroValues,
+			},
+			"region": {
+				Type:         schema.TypeString,
+				Required:     true,
+				ForceNew:     true,
+				Description:  "Abrha region slug for the namespace's location",
+				ValidateFunc: validation.NoZeroValues,
+			},
+
+			// Computed attributes
+			"uuid": {
+				Type:        schema.TypeString,
+				Computed:    true,
+				Description: "The UUID of the functions namespace",
+			},
+			"api_host": {
+				Type:        schema.TypeString,
+				Computed:    true,
+				Description: "The API host used to invoke functions in the namespace",
+			},
+			"key": {
+				Type:        schema.TypeString,
+				Computed:    true,
+				Sensitive:   true,
+				Description: "The access key used to authenticate against the namespace",
+			},
+			"created_at": {
+				Type:        schema.TypeString,
+				Computed:    true,
+				Description: "The date and time of when the namespace was created",
+			},
+			"updated_at": {
+				Type:        schema.TypeString,
+				Computed:    true,
+				Description: "The date and time of when the namespace was last updated",
+			},
+		},
+	}
+}
+
+func resourceAbrhaFunctionNamespaceCreate(ctx context.Context, d *schema.ResourceData, meta interface{}) diag.Diagnostics {
+	client := meta.(*config.CombinedConfig).GoApiAbrhaClient()
+
+	opts := &goApiAbrha.FunctionsNamespaceCreateRequest{
+		Label:  d.Get("label").(string),
+		Region: d.Get("region").(string),
+	}
+
+	log.Printf("[DEBUG] Functions namespace create request: %#v", opts)
+	namespace, _, err := client.Functions.CreateNamespace(context.Background(), opts)
+	if err != nil {
+		return diag.Errorf("Error creating functions namespace: %s", err)
+	}
+
+	d.SetId(namespace.Namespace)
+	log.Printf("[INFO] Functions namespace created, ID: %s", d.Id())
+
+	return resourceAbrhaFunctionNamespaceRead(ctx, d, meta)
+}
+
+func resourceAbrhaFunctionNamespaceRead(ctx context.Context, d *schema.ResourceData, meta interface{}) diag.Diagnostics {
+	client := meta.(*config.CombinedConfig).GoApiAbrhaClient()
+
+	namespace, resp, err := client.Functions.GetNamespace(context.Background(), d.Id())
+	if err != nil {
+		if resp != nil && resp.StatusCode == 404 {
+			log.Printf("[DEBUG] Functions namespace (%s) was not found - removing from state", d.Id())
+			d.SetId("")
+			return nil
+		}
+		return diag.Errorf("Error reading functions namespace: %s", err)
+	}
+
+	setFunctionNamespaceAttributes(d, namespace)
+
+	return nil
+}
+
+func resourceAbrhaFunctionNamespaceDelete(ctx context.Context, d *schema.ResourceData, meta interface{}) diag.Diagnostics {
+	client := meta.(*config.CombinedConfig).GoApiAbrhaClient()
+
+	log.Printf("[INFO] Deleting functions namespace: %s", d.Id())
+	resp, err := client.Functions.DeleteNamespace(context.Background(), d.Id())
+	if err != nil {
+		if resp != nil && resp.StatusCode == 404 {
+			d.SetId("")
+			return nil
+		}
+		return diag.Errorf("Error deleting functions namespace: %s", err)
+	}
+
+	d.SetId("")
+	return nil
+}
+
+func setFunctionNamespaceAttributes(d *schema.ResourceData, namespace *goApiAbrha.FunctionsNamespace) {
+	d.Set("label", namespace.Label)
+	d.Set("region", namespace.Region)
+	d.Set("uuid", namespace.UUID)
+	d.Set("api_host", namespace.ApiHost)
+	d.Set("key", namespace.Key)
+	d.Set("created_at", namespace.CreatedAt.UTC().Format(time.RFC3339))
+	d.Set("updated_at", namespace.UpdatedAt.UTC().Format(time.RFC3339))
+}
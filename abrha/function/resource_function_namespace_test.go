@@ -0,0 +1,95 @@
+package function_test
+
+import (
+	"context"
+	"fmt"
+	"testing"
+
+	goApiAbrha "github.com/abrhacom/go-api-abrha"
+	"github.com/abrhacom/terraform-provider-abrha/abrha/acceptance"
+	"github.com/abrhacom/terraform-provider-abrha/abrha/config"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/resource"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/terraform"
+)
+
+func TestAccAbrhaFunctionNamespace_Basic(t *testing.T) {
+	var namespace goApiAbrha.FunctionsNamespace
+	namespaceLabel := acceptance.RandomTestName()
+
+	resource.ParallelTest(t, resource.TestCase{
+		PreCheck:          func() { acceptance.TestAccPreCheck(t) },
+		ProviderFactories: acceptance.TestAccProviderFactories,
+		CheckDestroy:      testAccCheckAbrhaFunctionNamespaceDestroy,
+		Steps: []resource.TestStep{
+			{
+				Config: fmt.Sprintf(testAccCheckAbrhaFunctionNamespaceConfig_Basic, namespaceLabel),
+				Check: resource.ComposeTestCheckFunc(
+					testAccCheckAbrhaFunctionNamespaceExists("abrha_function_namespace.foobar", &namespace),
+					resource.TestCheckResourceAttr(
+						"abrha_function_namespace.foobar", "label", namespaceLabel),
+					resource.TestCheckResourceAttr(
+						"abrha_function_namespace.foobar", "region", "nyc1"),
+					resource.TestCheckResourceAttrSet(
+						"abrha_function_namespace.foobar", "uuid"),
+					resource.TestCheckResourceAttrSet(
+						"abrha_function_namespace.foobar", "api_host"),
+					resource.TestCheckResourceAttrSet(
+						"abrha_function_namespace.foobar", "key"),
+					resource.TestCheckResourceAttrSet(
+						"abrha_function_namespace.foobar", "created_at"),
+				),
+			},
+		},
+	})
+}
+
+func testAccCheckAbrhaFunctionNamespaceDestroy(s *terraform.State) error {
+	client := acceptance.TestAccProvider.Meta().(*config.CombinedConfig).GoApiAbrhaClient()
+
+	for _, rs := range s.RootModule().Resources {
+		if rs.Type != "abrha_function_namespace" {
+			continue
+		}
+
+		_, _, err := client.Functions.GetNamespace(context.Background(), rs.Primary.ID)
+		if err == nil {
+			return fmt.Errorf("Functions namespace still exists")
+		}
+	}
+
+	return nil
+}
+
+func testAccCheckAbrhaFunctionNamespaceExists(n string, namespace *goApiAbrha.FunctionsNamespace) resource.TestCheckFunc {
+	return func(s *terraform.State) error {
+		rs, ok := s.RootModule().Resources[n]
+		if !ok {
+			return fmt.Errorf("Not found: %s", n)
+		}
+
+		if rs.Primary.ID == "" {
+			return fmt.Errorf("No functions namespace ID is set")
+		}
+
+		client := acceptance.TestAccProvider.Meta().(*config.CombinedConfig).GoApiAbrhaClient()
+
+		foundNamespace, _, err := client.Functions.GetNamespace(context.Background(), rs.Primary.ID)
+		if err != nil {
+			return err
+		}
+
+		if foundNamespace.Namespace != rs.Primary.ID {
+			return fmt.Errorf("Functions namespace not found")
+		}
+
+		*namespace = *foundNamespace
+
+		return nil
+	}
+}
+
+const testAccCheckAbrhaFunctionNamespaceConfig_Basic = `
+resource "abrha_function_namespace" "foobar" {
+  label  = "%s"
+  region = "nyc1"
+}`
@@ -0,0 +1,320 @@
+package function
+
+import (
+	"context"
+	"encoding/json"
+	"errors"
+	"fmt"
+	"log"
+	"strings"
+	"time"
+
+	goApiAbrha "github.com/abrhacom/go-api-abrha"
+	"github.com/abrhacom/terraform-provider-abrha/abrha/config"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/diag"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/schema"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/structure"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/validation"
+)
+
+const triggerTypeScheduled = "SCHEDULED"
+
+func ResourceAbrhaFunctionTrigger() *schema.Resource {
+	return &schema.Resource{
+		CreateContext: resourceAbrhaFunctionTriggerCreate,
+		ReadContext:   resourceAbrhaFunctionTriggerRead,
+		UpdateContext: resourceAbrhaFunctionTriggerUpdate,
+		DeleteContext: resourceAbrhaFunctionTriggerDelete,
+		Importer: &schema.ResourceImporter{
+			State: resourceAbrhaFunctionTriggerImport,
+		},
+
+		Schema: map[string]*schema.Schema{
+			"namespace": {
+				Type:         schema.TypeString,
+				Required:     true,
+				ForceNew:     true,
+				Description:  "The ID of the functions namespace the trigger belongs to",
+				ValidateFunc: validation.NoZeroValues,
+			},
+			"name": {
+				Type:         schema.TypeString,
+				Required:     true,
+				ForceNew:     true,
+				Description:  "The name of the trigger",
+				ValidateFunc: validation.NoZeroValues,
+			},
+			"function": {
+				Type:         schema.TypeString,
+				Required:     true,
+				ForceNew:     true,
+				Description:  "The name of the function invoked by the trigger, e.g. `package/function`",
+				ValidateFunc: validation.NoZeroValues,
+			},
+			"type": {
+				Type:         schema.TypeString,
+				Optional:     true,
+				ForceNew:     true,
+				Default:      triggerTypeScheduled,
+				Description:  "The type of the trigger",
+				ValidateFunc: validation.StringInSlice([]string{triggerTypeScheduled}, false),
+			},
+			"is_enabled": {
+				Type:        schema.TypeBool,
+				Optional:    true,
+				Default:     true,
+				Description: "Whether or not the trigger is enabled",
+			},
+			"scheduled_details": {
+				Type:     schema.TypeList,
+				Required: true,
+				MinItems: 1,
+				MaxItems: 1,
+				Elem: &schema.Resource{
+					Schema: map[string]*schema.Schema{
+						"cron": {
+							Type:         schema.TypeString,
+							Required:     true,
+							Description:  "The cron expression describing when the function is invoked",
+							ValidateFunc: validation.NoZeroValues,
+						},
+						"body": {
+							Type:             schema.TypeString,
+							Optional:         true,
+							Description:      "A JSON object passed as parameters to the function on each invocation",
+							ValidateFunc:     validation.StringIsJSON,
+							DiffSuppressFunc: structure.SuppressJsonDiff,
+							StateFunc: func(v interface{}) string {
+								json, _ := structure.NormalizeJsonString(v)
+								return json
+							},
+						},
+					},
+				},
+			},
+
+			// Computed attributes
+			"scheduled_runs": {
+				Type:     schema.TypeList,
+				Computed: true,
+				Elem: &schema.Resource{
+					Schema: map[string]*schema.Schema{
+						"last_run_at": {
+							Type:     schema.TypeString,
+							Computed: true,
+						},
+						"next_run_at": {
+							Type:     schema.TypeString,
+							Computed: true,
+						},
+					},
+				},
+			},
+			"created_at": {
+				Type:        schema.TypeString,
+				Computed:    true,
+				Description: "The date and time of when the trigger was created",
+			},
+			"updated_at": {
+				Type:        schema.TypeString,
+				Computed:    true,
+				Description: "The date and time of when the trigger was last updated",
+			},
+		},
+	}
+}
+
+func resourceAbrhaFunctionTriggerCreate(ctx context.Context, d *schema.ResourceData, meta interface{}) diag.Diagnostics {
+	client := meta.(*config.CombinedConfig).GoApiAbrhaClient()
+	namespace := d.Get("namespace").(string)
+
+	details, err := expandTriggerScheduledDetails(d.Get("scheduled_details").([]interface{}))
+	if err != nil {
+		return diag.FromErr(err)
+	}
+
+	opts := &goApiAbrha.FunctionsTriggerCreateRequest{
+		Name:             d.Get("name").(string),
+		Type:             d.Get("type").(string),
+		Function:         d.Get("function").(string),
+		IsEnabled:        d.Get("is_enabled").(bool),
+		ScheduledDetails: details,
+	}
+
+	log.Printf("[DEBUG] Functions trigger create request: %#v", opts)
+	trigger, _, err := client.Functions.CreateTrigger(context.Background(), namespace, opts)
+	if err != nil {
+		return diag.Errorf("Error creating functions trigger: %s", err)
+	}
+
+	d.SetId(makeFunctionTriggerID(namespace, trigger.Name))
+	log.Printf("[INFO] Functions trigger created, ID: %s", d.Id())
+
+	return resourceAbrhaFunctionTriggerRead(ctx, d, meta)
+}
+
+func resourceAbrhaFunctionTriggerRead(ctx context.Context, d *schema.ResourceData, meta interface{}) diag.Diagnostics {
+	client := meta.(*config.CombinedConfig).GoApiAbrhaClient()
+	namespace := d.Get("namespace").(string)
+	name := d.Get("name").(string)
+
+	trigger, resp, err := client.Functions.GetTrigger(context.Background(), namespace, name)
+	if err != nil {
+		if resp != nil && resp.StatusCode == 404 {
+			log.Printf("[DEBUG] Functions trigger (%s) was not found - removing from state", d.Id())
+			d.SetId("")
+			return nil
+		}
+		return diag.Errorf("Error reading functions trigger: %s", err)
+	}
+
+	if err := setFunctionTriggerAttributes(d, trigger); err != nil {
+		return diag.FromErr(err)
+	}
+
+	return nil
+}
+
+func resourceAbrhaFunctionTriggerUpdate(ctx context.Context, d *schema.ResourceData, meta interface{}) diag.Diagnostics {
+	client := meta.(*config.CombinedConfig).GoApiAbrhaClient()
+	namespace := d.Get("namespace").(string)
+	name := d.Get("name").(string)
+
+	if d.HasChanges("is_enabled", "scheduled_details") {
+		details, err := expandTriggerScheduledDetails(d.Get("scheduled_details").([]interface{}))
+		if err != nil {
+			return diag.FromErr(err)
+		}
+
+		opts := &goApiAbrha.FunctionsTriggerUpdateRequest{
+			IsEnabled:        goApiAbrha.PtrTo(d.Get("is_enabled").(bool)),
+			ScheduledDetails: details,
+		}
+
+		log.Printf("[DEBUG] Functions trigger update request: %#v", opts)
+		_, _, err = client.Functions.UpdateTrigger(context.Background(), namespace, name, opts)
+		if err != nil {
+			return diag.Errorf("Error updating functions trigger: %s", err)
+		}
+		log.Printf("[INFO] Updated functions trigger: %s", d.Id())
+	}
+
+	return resourceAbrhaFunctionTriggerRead(ctx, d, meta)
+}
+
+func resourceAbrhaFunctionTriggerDelete(ctx context.Context, d *schema.ResourceData, meta interface{}) diag.Diagnostics {
+	client := meta.(*config.CombinedConfig).GoApiAbrhaClient()
+	namespace := d.Get("namespace").(string)
+	name := d.Get("name").(string)
+
+	log.Printf("[INFO] Deleting functions trigger: %s", d.Id())
+	resp, err := client.Functions.DeleteTrigger(context.Background(), namespace, name)
+	if err != nil {
+		if resp != nil && resp.StatusCode == 404 {
+			d.SetId("")
+			return nil
+		}
+		return diag.Errorf("Error deleting functions trigger: %s", err)
+	}
+
+	d.SetId("")
+	return nil
+}
+
+func resourceAbrhaFunctionTriggerImport(d *schema.ResourceData, meta interface{}) ([]*schema.ResourceData, error) {
+	if strings.Contains(d.Id(), ",") {
+		s := strings.Split(d.Id(), ",")
+		d.SetId(makeFunctionTriggerID(s[0], s[1]))
+		d.Set("namespace", s[0])
+		d.Set("name", s[1])
+	} else {
+		return nil, errors.New("must use the ID of the functions namespace and the name of the trigger joined with a comma (e.g. `namespace,name`)")
+	}
+
+	return []*schema.ResourceData{d}, nil
+}
+
+func makeFunctionTriggerID(namespace string, name string) string {
+	return fmt.Sprintf("%s/trigger/%s", namespace, name)
+}
+
+func setFunctionTriggerAttributes(d *schema.ResourceData, trigger *goApiAbrha.FunctionsTrigger) error {
+	d.Set("namespace", trigger.Namespace)
+	d.Set("name", trigger.Name)
+	d.Set("function", trigger.Function)
+	d.Set("type", trigger.Type)
+	d.Set("is_enabled", trigger.IsEnabled)
+	d.Set("created_at", trigger.CreatedAt.UTC().Format(time.RFC3339))
+	d.Set("updated_at", trigger.UpdatedAt.UTC().Format(time.RFC3339))
+
+	details, err := flattenTriggerScheduledDetails(trigger.ScheduledDetails)
+	if err != nil {
+		return err
+	}
+	if err := d.Set("scheduled_details", details); err != nil {
+		return fmt.Errorf("Error setting `scheduled_details`: %+v", err)
+	}
+
+	if err := d.Set("scheduled_runs", flattenTriggerScheduledRuns(trigger.ScheduledRuns)); err != nil {
+		return fmt.Errorf("Error setting `scheduled_runs`: %+v", err)
+	}
+
+	return nil
+}
+
+func expandTriggerScheduledDetails(config []interface{}) (*goApiAbrha.TriggerScheduledDetails, error) {
+	if len(config) == 0 || config[0] == nil {
+		return nil, nil
+	}
+	raw := config[0].(map[string]interface{})
+
+	details := &goApiAbrha.TriggerScheduledDetails{
+		Cron: raw["cron"].(string),
+	}
+
+	if body, ok := raw["body"].(string); ok && body != "" {
+		if err := json.Unmarshal([]byte(body), &details.Body); err != nil {
+			return nil, fmt.Errorf("Error parsing trigger body: %s", err)
+		}
+	}
+
+	return details, nil
+}
+
+func flattenTriggerScheduledDetails(details *goApiAbrha.TriggerScheduledDetails) ([]interface{}, error) {
+	if details == nil {
+		return []interface{}{}, nil
+	}
+
+	body := ""
+	if len(details.Body) > 0 {
+		raw, err := json.Marshal(details.Body)
+		if err != nil {
+			return nil, fmt.Errorf("Error serializing trigger body: %s", err)
+		}
+		body = string(raw)
+	}
+
+	return []interface{}{
+		map[string]interface{}{
+			"cron": details.Cron,
+			"body": body,
+		},
+	}, nil
+}
+
+func flattenTriggerScheduledRuns(runs *goApiAbrha.TriggerScheduledRuns) []interface{} {
+	if runs == nil {
+		return []interface{}{}
+	}
+
+	r := map[string]interface{}{}
+	if !runs.LastRunAt.IsZero() {
+		r["last_run_at"] = runs.LastRunAt.UTC().Format(time.RFC3339)
+	}
+	if !runs.NextRunAt.IsZero() {
+		r["next_run_at"] = runs.NextRunAt.UTC().Format(time.RFC3339)
+	}
+
+	return []interface{}{r}
+}
@@ -0,0 +1,128 @@
+package function_test
+
+import (
+	"context"
+	"fmt"
+	"testing"
+
+	goApiAbrha "github.com/abrhacom/go-api-abrha"
+	"github.com/abrhacom/terraform-provider-abrha/abrha/acceptance"
+	"github.com/abrhacom/terraform-provider-abrha/abrha/config"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/resource"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/terraform"
+)
+
+func TestAccAbrhaFunctionTrigger_Basic(t *testing.T) {
+	var trigger goApiAbrha.FunctionsTrigger
+	namespaceLabel := acceptance.RandomTestName()
+	triggerName := acceptance.RandomTestName("trigger")
+
+	resource.ParallelTest(t, resource.TestCase{
+		PreCheck:          func() { acceptance.TestAccPreCheck(t) },
+		ProviderFactories: acceptance.TestAccProviderFactories,
+		CheckDestroy:      testAccCheckAbrhaFunctionTriggerDestroy,
+		Steps: []resource.TestStep{
+			{
+				Config: fmt.Sprintf(testAccCheckAbrhaFunctionTriggerConfig_Basic, namespaceLabel, triggerName, true, "*/5 * * * *"),
+				Check: resource.ComposeTestCheckFunc(
+					testAccCheckAbrhaFunctionTriggerExists("abrha_function_trigger.foobar", &trigger),
+					resource.TestCheckResourceAttr(
+						"abrha_function_trigger.foobar", "name", triggerName),
+					resource.TestCheckResourceAttr(
+						"abrha_function_trigger.foobar", "function", "sample/hello"),
+					resource.TestCheckResourceAttr(
+						"abrha_function_trigger.foobar", "type", "SCHEDULED"),
+					resource.TestCheckResourceAttr(
+						"abrha_function_trigger.foobar", "is_enabled", "true"),
+					resource.TestCheckResourceAttr(
+						"abrha_function_trigger.foobar", "scheduled_details.0.cron", "*/5 * * * *"),
+					resource.TestCheckResourceAttr(
+						"abrha_function_trigger.foobar", "scheduled_details.0.body", `{"name":"world"}`),
+					resource.TestCheckResourceAttrPair(
+						"abrha_function_trigger.foobar", "namespace", "abrha_function_namespace.foobar", "id"),
+					resource.TestCheckResourceAttrSet(
+						"abrha_function_trigger.foobar", "created_at"),
+				),
+			},
+			{
+				Config: fmt.Sprintf(testAccCheckAbrhaFunctionTriggerConfig_Basic, namespaceLabel, triggerName, false, "0 * * * *"),
+				Check: resource.ComposeTestCheckFunc(
+					testAccCheckAbrhaFunctionTriggerExists("abrha_function_trigger.foobar", &trigger),
+					resource.TestCheckResourceAttr(
+						"abrha_function_trigger.foobar", "is_enabled", "false"),
+					resource.TestCheckResourceAttr(
+						"abrha_function_trigger.foobar", "scheduled_details.0.cron", "0 * * * *"),
+				),
+			},
+		},
+	})
+}
+
+func testAccCheckAbrhaFunctionTriggerDestroy(s *terraform.State) error {
+	client := acceptance.TestAccProvider.Meta().(*config.CombinedConfig).GoApiAbrhaClient()
+
+	for _, rs := range s.RootModule().Resources {
+		if rs.Type != "abrha_function_trigger" {
+			continue
+		}
+		namespace := rs.Primary.Attributes["namespace"]
+		name := rs.Primary.Attributes["name"]
+
+		_, _, err := client.Functions.GetTrigger(context.Background(), namespace, name)
+		if err == nil {
+			return fmt.Errorf("Functions trigger still exists")
+		}
+	}
+
+	return nil
+}
+
+func testAccCheckAbrhaFunctionTriggerExists(n string, trigger *goApiAbrha.FunctionsTrigger) resource.TestCheckFunc {
+	return func(s *terraform.State) error {
+		rs, ok := s.RootModule().Resources[n]
+		if !ok {
+			return fmt.Errorf("Not found: %s", n)
+		}
+
+		if rs.Primary.ID == "" {
+			return fmt.Errorf("No functions trigger ID is set")
+		}
+
+		client := acceptance.TestAccProvider.Meta().(*config.CombinedConfig).GoApiAbrhaClient()
+		namespace := rs.Primary.Attributes["namespace"]
+		name := rs.Primary.Attributes["name"]
+
+		foundTrigger, _, err := client.Functions.GetTrigger(context.Background(), namespace, name)
+		if err != nil {
+			return err
+		}
+
+		if foundTrigger.Name != name {
+			return fmt.Errorf("Functions trigger not found")
+		}
+
+		*trigger = *foundTrigger
+
+		return nil
+	}
+}
+
+const testAccCheckAbrhaFunctionTriggerConfig_Basic = `
+resource "abrha_function_namespace" "foobar" {
+  label  = "%s"
+  region = "nyc1"
+}
+
+resource "abrha_function_trigger" "foobar" {
+  namespace  = abrha_function_namespace.foobar.id
+  name       = "%s"
+  function   = "sample/hello"
+  is_enabled = %t
+
+  scheduled_details {
+    cron = "%s"
+    body = jsonencode({
+      name = "world"
+    })
+  }
+}`
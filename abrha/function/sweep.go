@@ -0,0 +1,43 @@
+package function
+
+import (
+	"context"
+	"log"
+	"strings"
+
+	"github.com/abrhacom/terraform-provider-abrha/abrha/config"
+	"github.com/abrhacom/terraform-provider-abrha/abrha/sweep"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/resource"
+)
+
+func init() {
+	resource.AddTestSweepers("abrha_function_namespace", &resource.Sweeper{
+		Name: "abrha_function_namespace",
+		F:    sweepFunctionNamespace,
+	})
+}
+
+func sweepFunctionNamespace(region string) error {
+	meta, err := sweep.SharedConfigForRegion(region)
+	if err != nil {
+		return err
+	}
+
+	client := meta.(*config.CombinedConfig).GoApiAbrhaClient()
+
+	namespaces, _, err := client.Functions.ListNamespaces(context.Background())
+	if err != nil {
+		return err
+	}
+
+	for _, n := range namespaces {
+		if strings.HasPrefix(n.Label, sweep.TestNamePrefix) {
+			log.Printf("[DEBUG] Destroying functions namespace %s", n.Label)
+			if _, err := client.Functions.DeleteNamespace(context.Background(), n.Namespace); err != nil {
+				return err
+			}
+		}
+	}
+
+	return nil
+}
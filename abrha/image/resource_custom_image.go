@@ -98,6 +98,7 @@ func ResourceAbrhaCustomImage() *schema.Resource {
 
 		Timeouts: &schema.ResourceTimeout{
 			Create: schema.DefaultTimeout(60 * time.Minute),
+			Update: schema.DefaultTimeout(60 * time.Minute),
 		},
 
 		// Images can not currently be removed from a region.
@@ -152,7 +153,7 @@ func resourceAbrhaCustomImageCreate(ctx context.Context, d *schema.ResourceData,
 		regions[len(regions)-1] = ""
 		regions = regions[:len(regions)-1]
 		log.Printf("[INFO] Image available in: %s Distributing to: %v", region, regions)
-		err = distributeImageToRegions(client, imageResponse.ID, regions)
+		err = distributeImageToRegions(ctx, client, imageResponse.ID, regions, d.Timeout(schema.TimeoutCreate))
 		if err != nil {
 			return diag.Errorf("Error distributing image (%s) to additional regions: %s", d.Id(), err)
 		}
@@ -225,7 +226,7 @@ func resourceAbrhaCustomImageUpdate(ctx context.Context, d *schema.ResourceData,
 	if d.HasChange("regions") {
 		old, new := d.GetChange("regions")
 		_, add := util.GetSetChanges(old.(*schema.Set), new.(*schema.Set))
-		err = distributeImageToRegions(client, id, add.List())
+		err = distributeImageToRegions(ctx, client, id, add.List(), d.Timeout(schema.TimeoutUpdate))
 		if err != nil {
 			return diag.Errorf("Error distributing image (%s) to additional regions: %s", d.Id(), err)
 		}
@@ -293,7 +294,7 @@ func imageStateRefreshFunc(ctx context.Context, d *schema.ResourceData, state st
 	}
 }
 
-func distributeImageToRegions(client *goApiAbrha.Client, imageId int, regions []interface{}) (err error) {
+func distributeImageToRegions(ctx context.Context, client *goApiAbrha.Client, imageId int, regions []interface{}, timeout time.Duration) (err error) {
 	for _, region := range regions {
 		transferRequest := &goApiAbrha.ActionRequest{
 			"type":   "transfer",
@@ -301,12 +302,12 @@ func distributeImageToRegions(client *goApiAbrha.Client, imageId int, regions []
 		}
 
 		log.Printf("[INFO] Transferring image (%d) to: %s", imageId, region)
-		action, _, err := client.ImageActions.Transfer(context.TODO(), imageId, transferRequest)
+		action, _, err := client.ImageActions.Transfer(ctx, imageId, transferRequest)
 		if err != nil {
 			return err
 		}
 
-		err = util.WaitForAction(client, action)
+		err = util.WaitForAction(ctx, client, action, timeout)
 		if err != nil {
 			return err
 		}
@@ -0,0 +1,224 @@
+package kubernetes
+
+import (
+	"context"
+	"fmt"
+	"log"
+	"net/http"
+	"sort"
+	"strings"
+	"time"
+
+	goApiAbrha "github.com/abrhacom/go-api-abrha"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/retry"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/schema"
+)
+
+// clusterlintSeverities lists the severities reported by clusterlint, from
+// most to least severe.
+var clusterlintSeverities = []string{"error", "warning", "suggestion"}
+
+func clusterlintDiagnosticsSchema() *schema.Schema {
+	return &schema.Schema{
+		Type:     schema.TypeList,
+		Computed: true,
+		Elem: &schema.Resource{
+			Schema: map[string]*schema.Schema{
+				"check_name": {
+					Type:     schema.TypeString,
+					Computed: true,
+				},
+				"severity": {
+					Type:     schema.TypeString,
+					Computed: true,
+				},
+				"message": {
+					Type:     schema.TypeString,
+					Computed: true,
+				},
+				"object": {
+					Type:     schema.TypeList,
+					Computed: true,
+					Elem: &schema.Resource{
+						Schema: map[string]*schema.Schema{
+							"kind": {
+								Type:     schema.TypeString,
+								Computed: true,
+							},
+							"name": {
+								Type:     schema.TypeString,
+								Computed: true,
+							},
+							"namespace": {
+								Type:     schema.TypeString,
+								Computed: true,
+							},
+							"owners": {
+								Type:     schema.TypeList,
+								Computed: true,
+								Elem: &schema.Resource{
+									Schema: map[string]*schema.Schema{
+										"kind": {
+											Type:     schema.TypeString,
+											Computed: true,
+										},
+										"name": {
+											Type:     schema.TypeString,
+											Computed: true,
+										},
+									},
+								},
+							},
+						},
+					},
+				},
+			},
+		},
+	}
+}
+
+func clusterlintSeverityCountsSchema() *schema.Schema {
+	return &schema.Schema{
+		Type:        schema.TypeMap,
+		Computed:    true,
+		Description: "The number of diagnostics reported for each severity",
+		Elem: &schema.Schema{
+			Type: schema.TypeInt,
+		},
+	}
+}
+
+func flattenClusterlintDiagnostics(diagnostics []*goApiAbrha.ClusterlintDiagnostic) []interface{} {
+	result := make([]interface{}, 0, len(diagnostics))
+
+	for _, d := range diagnostics {
+		if d == nil {
+			continue
+		}
+
+		object := []interface{}{}
+		if d.Object != nil {
+			owners := make([]interface{}, 0, len(d.Object.Owners))
+			for _, o := range d.Object.Owners {
+				if o == nil {
+					continue
+				}
+				owners = append(owners, map[string]interface{}{
+					"kind": o.Kind,
+					"name": o.Name,
+				})
+			}
+
+			object = append(object, map[string]interface{}{
+				"kind":      d.Object.Kind,
+				"name":      d.Object.Name,
+				"namespace": d.Object.Namespace,
+				"owners":    owners,
+			})
+		}
+
+		result = append(result, map[string]interface{}{
+			"check_name": d.CheckName,
+			"severity":   d.Severity,
+			"message":    d.Message,
+			"object":     object,
+		})
+	}
+
+	return result
+}
+
+func countClusterlintSeverities(diagnostics []*goApiAbrha.ClusterlintDiagnostic) map[string]interface{} {
+	counts := map[string]interface{}{}
+	for _, s := range clusterlintSeverities {
+		counts[s] = 0
+	}
+
+	for _, d := range diagnostics {
+		if d == nil {
+			continue
+		}
+		if c, ok := counts[d.Severity].(int); ok {
+			counts[d.Severity] = c + 1
+		} else {
+			counts[d.Severity] = 1
+		}
+	}
+
+	return counts
+}
+
+// clusterlintDiagnosticsAtOrAbove returns the diagnostics whose severity is at
+// least as severe as the given threshold.
+func clusterlintDiagnosticsAtOrAbove(diagnostics []*goApiAbrha.ClusterlintDiagnostic, threshold string) []*goApiAbrha.ClusterlintDiagnostic {
+	rank := map[string]int{}
+	for i, s := range clusterlintSeverities {
+		rank[s] = i
+	}
+
+	limit, ok := rank[threshold]
+	if !ok {
+		return nil
+	}
+
+	var result []*goApiAbrha.ClusterlintDiagnostic
+	for _, d := range diagnostics {
+		if d == nil {
+			continue
+		}
+		if r, ok := rank[d.Severity]; ok && r <= limit {
+			result = append(result, d)
+		}
+	}
+
+	return result
+}
+
+func formatClusterlintDiagnostics(diagnostics []*goApiAbrha.ClusterlintDiagnostic) string {
+	lines := make([]string, 0, len(diagnostics))
+	for _, d := range diagnostics {
+		object := ""
+		if d.Object != nil {
+			object = fmt.Sprintf(" (%s %s/%s)", d.Object.Kind, d.Object.Namespace, d.Object.Name)
+		}
+		lines = append(lines, fmt.Sprintf("  - [%s] %s%s: %s", d.Severity, d.CheckName, object, d.Message))
+	}
+	sort.Strings(lines)
+
+	return strings.Join(lines, "\n")
+}
+
+// waitForClusterlintResults polls for the results of a clusterlint run. The API
+// responds with a 404 until the run has completed.
+func waitForClusterlintResults(ctx context.Context, client *goApiAbrha.Client, clusterID string, runID string, timeout time.Duration) ([]*goApiAbrha.ClusterlintDiagnostic, error) {
+	log.Printf("[INFO] Waiting for clusterlint run %s on cluster %s to complete", runID, clusterID)
+	stateConf := &retry.StateChangeConf{
+		Pending: []string{"running"},
+		Target:  []string{"done"},
+		Refresh: func() (interface{}, string, error) {
+			diagnostics, resp, err := client.Kubernetes.GetClusterlintResults(ctx, clusterID, &goApiAbrha.KubernetesGetClusterlintRequest{RunId: runID})
+			if err != nil {
+				if resp != nil && resp.StatusCode == http.StatusNotFound {
+					return nil, "running", nil
+				}
+				return nil, "", err
+			}
+
+			if diagnostics == nil {
+				diagnostics = []*goApiAbrha.ClusterlintDiagnostic{}
+			}
+
+			return diagnostics, "done", nil
+		},
+		Timeout:    timeout,
+		Delay:      5 * time.Second,
+		MinTimeout: 5 * time.Second,
+	}
+
+	result, err := stateConf.WaitForStateContext(ctx)
+	if err != nil {
+		return nil, fmt.Errorf("Error waiting for clusterlint run %s to complete: %s", runID, err)
+	}
+
+	return result.([]*goApiAbrha.ClusterlintDiagnostic), nil
+}
@@ -123,6 +123,8 @@ func DataSourceAbrhaKubernetesCluster() *schema.Resource {
 
 			"kube_config": kubernetesConfigSchema(),
 
+			"kube_config_expiry_seconds": kubeConfigExpirySecondsSchema(),
+
 			"auto_upgrade": {
 				Type:     schema.TypeBool,
 				Computed: true,
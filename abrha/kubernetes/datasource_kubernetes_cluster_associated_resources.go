@@ -0,0 +1,55 @@
+package kubernetes
+
+import (
+	"context"
+
+	"github.com/abrhacom/terraform-provider-abrha/abrha/config"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/diag"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/schema"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/validation"
+)
+
+// DataSourceAbrhaKubernetesClusterAssociatedResources lists the resources
+// that can be selected for deletion along with a cluster through its
+// destroy_associated_* arguments.
+func DataSourceAbrhaKubernetesClusterAssociatedResources() *schema.Resource {
+	return &schema.Resource{
+		ReadContext: dataSourceAbrhaKubernetesClusterAssociatedResourcesRead,
+		Schema: map[string]*schema.Schema{
+			"cluster_id": {
+				Type:         schema.TypeString,
+				Required:     true,
+				ValidateFunc: validation.NoZeroValues,
+			},
+			"load_balancers":   associatedResourceSchema("load balancers"),
+			"volumes":          associatedResourceSchema("volumes"),
+			"volume_snapshots": associatedResourceSchema("volume snapshots"),
+		},
+	}
+}
+
+func dataSourceAbrhaKubernetesClusterAssociatedResourcesRead(ctx context.Context, d *schema.ResourceData, meta interface{}) diag.Diagnostics {
+	client := meta.(*config.CombinedConfig).GoApiAbrhaClient()
+	clusterID := d.Get("cluster_id").(string)
+
+	list, _, err := client.Kubernetes.ListAssociatedResourcesForDeletion(ctx, clusterID)
+	if err != nil {
+		return diag.Errorf("Error retrieving resources associated with Kubernetes cluster: %s", err)
+	}
+
+	d.SetId(clusterID)
+
+	if err := d.Set("load_balancers", flattenAssociatedResourceList(list.LoadBalancers)); err != nil {
+		return diag.Errorf("Error setting load_balancers: %s", err)
+	}
+
+	if err := d.Set("volumes", flattenAssociatedResourceList(list.Volumes)); err != nil {
+		return diag.Errorf("Error setting volumes: %s", err)
+	}
+
+	if err := d.Set("volume_snapshots", flattenAssociatedResourceList(list.VolumeSnapshots)); err != nil {
+		return diag.Errorf("Error setting volume_snapshots: %s", err)
+	}
+
+	return nil
+}
@@ -0,0 +1,49 @@
+package kubernetes_test
+
+import (
+	"fmt"
+	"testing"
+
+	goApiAbrha "github.com/abrhacom/go-api-abrha"
+	"github.com/abrhacom/terraform-provider-abrha/abrha/acceptance"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/resource"
+)
+
+func TestAccDataSourceAbrhaKubernetesClusterAssociatedResources_Basic(t *testing.T) {
+	rName := acceptance.RandomTestName()
+	var k8s goApiAbrha.KubernetesCluster
+
+	resourceConfig := testAccAbrhaKubernetesConfigBasic(testClusterVersionLatest, rName)
+	datasourceConfig := `
+data "abrha_kubernetes_cluster_associated_resources" "foobar" {
+  cluster_id = abrha_kubernetes_cluster.foobar.id
+}`
+
+	resource.ParallelTest(t, resource.TestCase{
+		PreCheck:          func() { acceptance.TestAccPreCheck(t) },
+		ProviderFactories: acceptance.TestAccProviderFactories,
+		CheckDestroy:      testAccCheckAbrhaKubernetesClusterDestroy,
+		Steps: []resource.TestStep{
+			{
+				Config: resourceConfig,
+				Check: resource.ComposeTestCheckFunc(
+					testAccCheckAbrhaKubernetesClusterExists("abrha_kubernetes_cluster.foobar", &k8s),
+				),
+			},
+			{
+				Config: fmt.Sprintf("%s\n%s", resourceConfig, datasourceConfig),
+				Check: resource.ComposeTestCheckFunc(
+					resource.TestCheckResourceAttrPair(
+						"data.abrha_kubernetes_cluster_associated_resources.foobar", "id",
+						"abrha_kubernetes_cluster.foobar", "id"),
+					resource.TestCheckResourceAttr(
+						"data.abrha_kubernetes_cluster_associated_resources.foobar", "load_balancers.#", "0"),
+					resource.TestCheckResourceAttr(
+						"data.abrha_kubernetes_cluster_associated_resources.foobar", "volumes.#", "0"),
+					resource.TestCheckResourceAttr(
+						"data.abrha_kubernetes_cluster_associated_resources.foobar", "volume_snapshots.#", "0"),
+				),
+			},
+		},
+	})
+}
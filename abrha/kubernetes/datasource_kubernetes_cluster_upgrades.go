@@ -0,0 +1,72 @@
+package kubernetes
+
+import (
+	"context"
+
+	"github.com/abrhacom/terraform-provider-abrha/abrha/config"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/diag"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/schema"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/validation"
+)
+
+func DataSourceAbrhaKubernetesClusterUpgrades() *schema.Resource {
+	return &schema.Resource{
+		ReadContext: dataSourceAbrhaKubernetesClusterUpgradesRead,
+		Schema: map[string]*schema.Schema{
+			"cluster_id": {
+				Type:         schema.TypeString,
+				Required:     true,
+				ValidateFunc: validation.NoZeroValues,
+			},
+			"current_version": {
+				Type:     schema.TypeString,
+				Computed: true,
+			},
+			"valid_versions": {
+				Type:        schema.TypeList,
+				Computed:    true,
+				Elem:        &schema.Schema{Type: schema.TypeString},
+				Description: "The versions the cluster can be upgraded to, newest first",
+			},
+			"latest_version": {
+				Type:     schema.TypeString,
+				Computed: true,
+			},
+			"latest_patch_version": {
+				Type:        schema.TypeString,
+				Computed:    true,
+				Description: "The newest upgrade within the cluster's current minor version, if any",
+			},
+		},
+	}
+}
+
+func dataSourceAbrhaKubernetesClusterUpgradesRead(ctx context.Context, d *schema.ResourceData, meta interface{}) diag.Diagnostics {
+	client := meta.(*config.CombinedConfig).GoApiAbrhaClient()
+	clusterID := d.Get("cluster_id").(string)
+
+	cluster, _, err := client.Kubernetes.Get(ctx, clusterID)
+	if err != nil {
+		return diag.Errorf("Error retrieving Kubernetes cluster: %s", err)
+	}
+
+	upgrades, _, err := client.Kubernetes.GetUpgrades(ctx, clusterID)
+	if err != nil {
+		return diag.Errorf("Error retrieving Kubernetes cluster upgrades: %s", err)
+	}
+
+	d.SetId(clusterID)
+
+	validVersions := sortedUpgradeSlugs(upgrades)
+	d.Set("current_version", cluster.VersionSlug)
+	d.Set("valid_versions", validVersions)
+	d.Set("latest_patch_version", latestPatchUpgrade(cluster.VersionSlug, upgrades))
+
+	if len(validVersions) > 0 {
+		d.Set("latest_version", validVersions[0])
+	} else {
+		d.Set("latest_version", "")
+	}
+
+	return nil
+}
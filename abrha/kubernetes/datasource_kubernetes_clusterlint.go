@@ -0,0 +1,53 @@
+package kubernetes
+
+import (
+	"context"
+	"fmt"
+
+	goApiAbrha "github.com/abrhacom/go-api-abrha"
+	"github.com/abrhacom/terraform-provider-abrha/abrha/config"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/diag"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/schema"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/validation"
+)
+
+func DataSourceAbrhaKubernetesClusterlint() *schema.Resource {
+	return &schema.Resource{
+		ReadContext: dataSourceAbrhaKubernetesClusterlintRead,
+		Schema: map[string]*schema.Schema{
+			"cluster_id": {
+				Type:         schema.TypeString,
+				Required:     true,
+				ValidateFunc: validation.NoZeroValues,
+			},
+			"run_id": {
+				Type:        schema.TypeString,
+				Optional:    true,
+				Description: "The ID of the clusterlint run; defaults to the most recent run",
+			},
+			"diagnostics":     clusterlintDiagnosticsSchema(),
+			"severity_counts": clusterlintSeverityCountsSchema(),
+		},
+	}
+}
+
+func dataSourceAbrhaKubernetesClusterlintRead(ctx context.Context, d *schema.ResourceData, meta interface{}) diag.Diagnostics {
+	client := meta.(*config.CombinedConfig).GoApiAbrhaClient()
+	clusterID := d.Get("cluster_id").(string)
+	runID := d.Get("run_id").(string)
+
+	diagnostics, _, err := client.Kubernetes.GetClusterlintResults(context.Background(), clusterID, &goApiAbrha.KubernetesGetClusterlintRequest{RunId: runID})
+	if err != nil {
+		return diag.Errorf("Error retrieving clusterlint results: %s", err)
+	}
+
+	if runID == "" {
+		d.SetId(fmt.Sprintf("%s/clusterlint", clusterID))
+	} else {
+		d.SetId(fmt.Sprintf("%s/clusterlint/%s", clusterID, runID))
+	}
+	d.Set("diagnostics", flattenClusterlintDiagnostics(diagnostics))
+	d.Set("severity_counts", countClusterlintSeverities(diagnostics))
+
+	return nil
+}
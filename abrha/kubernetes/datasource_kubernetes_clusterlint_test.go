@@ -0,0 +1,38 @@
+package kubernetes_test
+
+import (
+	"testing"
+
+	"github.com/abrhacom/terraform-provider-abrha/abrha/acceptance"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/resource"
+)
+
+func TestAccDataSourceAbrhaKubernetesClusterlint_Basic(t *testing.T) {
+	rName := acceptance.RandomTestName()
+	resourceConfig := testAccAbrhaKubernetesClusterlintConfig(testAccAbrhaKubernetesConfigBasic(testClusterVersionLatest, rName), "1")
+	dataSourceConfig := `
+data "abrha_kubernetes_clusterlint" "foobar" {
+  cluster_id = abrha_kubernetes_clusterlint.foobar.cluster_id
+  run_id     = abrha_kubernetes_clusterlint.foobar.run_id
+}`
+
+	resource.ParallelTest(t, resource.TestCase{
+		PreCheck:          func() { acceptance.TestAccPreCheck(t) },
+		ProviderFactories: acceptance.TestAccProviderFactories,
+		CheckDestroy:      testAccCheckAbrhaKubernetesClusterDestroy,
+		Steps: []resource.TestStep{
+			{
+				Config: resourceConfig,
+			},
+			{
+				Config: resourceConfig + dataSourceConfig,
+				Check: resource.ComposeTestCheckFunc(
+					resource.TestCheckResourceAttrPair(
+						"data.abrha_kubernetes_clusterlint.foobar", "diagnostics.#",
+						"abrha_kubernetes_clusterlint.foobar", "diagnostics.#"),
+					resource.TestCheckResourceAttrSet("data.abrha_kubernetes_clusterlint.foobar", "severity_counts.warning"),
+				),
+			},
+		},
+	})
+}
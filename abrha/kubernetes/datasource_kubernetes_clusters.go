@@ -0,0 +1,17 @@
+package kubernetes
+
+import (
+	"github.com/abrhacom/terraform-provider-abrha/internal/datalist"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/schema"
+)
+
+func DataSourceAbrhaKubernetesClusters() *schema.Resource {
+	dataListConfig := &datalist.ResourceConfig{
+		RecordSchema:        kubernetesClusterSchema(),
+		ResultAttributeName: "clusters",
+		GetRecords:          getAbrhaKubernetesClusters,
+		FlattenRecord:       flattenAbrhaKubernetesCluster,
+	}
+
+	return datalist.NewResource(dataListConfig)
+}
@@ -0,0 +1,51 @@
+package kubernetes_test
+
+import (
+	"fmt"
+	"testing"
+
+	"github.com/abrhacom/terraform-provider-abrha/abrha/acceptance"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/resource"
+)
+
+func TestAccDataSourceAbrhaKubernetesClusters_Basic(t *testing.T) {
+	rName := acceptance.RandomTestName()
+	resourceConfig := testAccAbrhaKubernetesConfigForDataSource(testClusterVersionLatest, rName)
+	datasourceConfig := fmt.Sprintf(`
+data "abrha_kubernetes_clusters" "result" {
+  filter {
+    key    = "name"
+    values = ["%s"]
+  }
+
+  filter {
+    key    = "tags"
+    values = ["foo"]
+  }
+}
+`, rName)
+
+	resource.ParallelTest(t, resource.TestCase{
+		PreCheck:          func() { acceptance.TestAccPreCheck(t) },
+		ProviderFactories: acceptance.TestAccProviderFactories,
+		Steps: []resource.TestStep{
+			{
+				Config: resourceConfig,
+			},
+			{
+				Config: resourceConfig + datasourceConfig,
+				Check: resource.ComposeTestCheckFunc(
+					resource.TestCheckResourceAttr("data.abrha_kubernetes_clusters.result", "clusters.#", "1"),
+					resource.TestCheckResourceAttr("data.abrha_kubernetes_clusters.result", "clusters.0.name", rName),
+					resource.TestCheckResourceAttr("data.abrha_kubernetes_clusters.result", "clusters.0.region", "lon1"),
+					resource.TestCheckResourceAttr("data.abrha_kubernetes_clusters.result", "clusters.0.auto_upgrade", "true"),
+					resource.TestCheckResourceAttr("data.abrha_kubernetes_clusters.result", "clusters.0.node_pool_names.0", "default"),
+					resource.TestCheckResourceAttrPair("data.abrha_kubernetes_clusters.result", "clusters.0.id", "abrha_kubernetes_cluster.foo", "id"),
+				),
+			},
+			{
+				Config: resourceConfig,
+			},
+		},
+	})
+}
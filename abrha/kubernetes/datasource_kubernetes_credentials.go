@@ -0,0 +1,98 @@
+package kubernetes
+
+import (
+	"context"
+	"fmt"
+
+	"github.com/abrhacom/terraform-provider-abrha/abrha/config"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/diag"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/schema"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/validation"
+)
+
+func DataSourceAbrhaKubernetesCredentials() *schema.Resource {
+	return &schema.Resource{
+		ReadContext: dataSourceAbrhaKubernetesCredentialsRead,
+		Schema: map[string]*schema.Schema{
+			"cluster_id": {
+				Type:         schema.TypeString,
+				Required:     true,
+				ValidateFunc: validation.NoZeroValues,
+			},
+
+			"expiry_seconds": {
+				Type:         schema.TypeInt,
+				Optional:     true,
+				ValidateFunc: validation.IntAtLeast(1),
+				Description:  "The number of seconds the credentials are valid for. The API default lifetime is used when unset.",
+			},
+
+			"raw_config": {
+				Type:      schema.TypeString,
+				Computed:  true,
+				Sensitive: true,
+			},
+
+			"host": {
+				Type:     schema.TypeString,
+				Computed: true,
+			},
+
+			"cluster_ca_certificate": {
+				Type:     schema.TypeString,
+				Computed: true,
+			},
+
+			"client_key": {
+				Type:      schema.TypeString,
+				Computed:  true,
+				Sensitive: true,
+			},
+
+			"client_certificate": {
+				Type:     schema.TypeString,
+				Computed: true,
+			},
+
+			"token": {
+				Type:      schema.TypeString,
+				Computed:  true,
+				Sensitive: true,
+			},
+
+			"expires_at": {
+				Type:     schema.TypeString,
+				Computed: true,
+			},
+		},
+	}
+}
+
+func dataSourceAbrhaKubernetesCredentialsRead(ctx context.Context, d *schema.ResourceData, meta interface{}) diag.Diagnostics {
+	client := meta.(*config.CombinedConfig).GoApiAbrhaClient()
+	clusterID := d.Get("cluster_id").(string)
+
+	cluster, _, err := client.Kubernetes.Get(ctx, clusterID)
+	if err != nil {
+		return diag.Errorf("Error retrieving Kubernetes cluster: %s", err)
+	}
+
+	// Data sources keep no prior state, so new credentials are fetched on
+	// every read.
+	creds, _, err := client.Kubernetes.GetCredentials(ctx, clusterID, expandKubernetesCredentialsRequest(d.Get("expiry_seconds").(int)))
+	if err != nil {
+		return diag.Errorf("Unable to fetch Kubernetes credentials: %s", err)
+	}
+
+	flattened := flattenCredentials(cluster.Name, cluster.RegionSlug, creds)
+	if len(flattened) == 0 {
+		return diag.Errorf("Unable to render Kubernetes credentials for cluster (%s)", clusterID)
+	}
+
+	d.SetId(fmt.Sprintf("%s/%d", clusterID, creds.ExpiresAt.Unix()))
+	for key, value := range flattened[0].(map[string]interface{}) {
+		d.Set(key, value)
+	}
+
+	return nil
+}
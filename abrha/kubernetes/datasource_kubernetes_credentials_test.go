@@ -0,0 +1,49 @@
+package kubernetes_test
+
+import (
+	"fmt"
+	"regexp"
+	"testing"
+
+	goApiAbrha "github.com/abrhacom/go-api-abrha"
+	"github.com/abrhacom/terraform-provider-abrha/abrha/acceptance"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/resource"
+)
+
+func TestAccDataSourceAbrhaKubernetesCredentials_Basic(t *testing.T) {
+	rName := acceptance.RandomTestName()
+	var k8s goApiAbrha.KubernetesCluster
+
+	resourceConfig := testAccAbrhaKubernetesConfigBasic(testClusterVersionLatest, rName)
+	datasourceConfig := `
+data "abrha_kubernetes_credentials" "foobar" {
+  cluster_id     = abrha_kubernetes_cluster.foobar.id
+  expiry_seconds = 900
+}`
+
+	resource.ParallelTest(t, resource.TestCase{
+		PreCheck:          func() { acceptance.TestAccPreCheck(t) },
+		ProviderFactories: acceptance.TestAccProviderFactories,
+		CheckDestroy:      testAccCheckAbrhaKubernetesClusterDestroy,
+		Steps: []resource.TestStep{
+			{
+				Config: resourceConfig,
+				Check: resource.ComposeTestCheckFunc(
+					testAccCheckAbrhaKubernetesClusterExists("abrha_kubernetes_cluster.foobar", &k8s),
+				),
+			},
+			{
+				Config: fmt.Sprintf("%s\n%s", resourceConfig, datasourceConfig),
+				Check: resource.ComposeTestCheckFunc(
+					resource.TestCheckResourceAttrPair(
+						"data.abrha_kubernetes_credentials.foobar", "host",
+						"abrha_kubernetes_cluster.foobar", "kube_config.0.host"),
+					resource.TestMatchResourceAttr("data.abrha_kubernetes_credentials.foobar", "id", regexp.MustCompile(`^[0-9a-f-]+/[0-9]+$`)),
+					resource.TestCheckResourceAttrSet("data.abrha_kubernetes_credentials.foobar", "token"),
+					resource.TestCheckResourceAttrSet("data.abrha_kubernetes_credentials.foobar", "expires_at"),
+					resource.TestCheckResourceAttrSet("data.abrha_kubernetes_credentials.foobar", "raw_config"),
+				),
+			},
+		},
+	})
+}
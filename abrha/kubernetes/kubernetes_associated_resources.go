@@ -0,0 +1,150 @@
+package kubernetes
+
+import (
+	"context"
+	"fmt"
+
+	goApiAbrha "github.com/abrhacom/go-api-abrha"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/schema"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/validation"
+)
+
+// allAssociatedResources selects every associated resource of a type for
+// deletion along with the cluster.
+const allAssociatedResources = "all"
+
+var destroyAssociatedResourceKeys = []string{
+	"destroy_associated_load_balancers",
+	"destroy_associated_volumes",
+	"destroy_associated_volume_snapshots",
+}
+
+func destroyAssociatedResourcesSchema(resourceType string) *schema.Schema {
+	return &schema.Schema{
+		Type:     schema.TypeSet,
+		Optional: true,
+		Elem: &schema.Schema{
+			Type:         schema.TypeString,
+			ValidateFunc: validation.NoZeroValues,
+		},
+		ConflictsWith: []string{"destroy_all_associated_resources"},
+		Description:   fmt.Sprintf("The IDs of the %s associated with the cluster to destroy along with it, or [\"%s\"] to destroy all of them", resourceType, allAssociatedResources),
+	}
+}
+
+func associatedResourceSchema(resourceType string) *schema.Schema {
+	return &schema.Schema{
+		Type:     schema.TypeList,
+		Computed: true,
+		Elem: &schema.Resource{
+			Schema: map[string]*schema.Schema{
+				"id": {
+					Type:     schema.TypeString,
+					Computed: true,
+				},
+
+				"name": {
+					Type:     schema.TypeString,
+					Computed: true,
+				},
+			},
+		},
+		Description: fmt.Sprintf("The %s associated with the cluster", resourceType),
+	}
+}
+
+func associatedResourcesSchema() *schema.Schema {
+	return &schema.Schema{
+		Type:        schema.TypeList,
+		Computed:    true,
+		Description: "The load balancers, volumes and volume snapshots that can be destroyed along with the cluster. Only populated when any destroy_associated_* argument is set, use the abrha_kubernetes_cluster_associated_resources data source to look up the IDs to select.",
+		Elem: &schema.Resource{
+			Schema: map[string]*schema.Schema{
+				"load_balancers":   associatedResourceSchema("load balancers"),
+				"volumes":          associatedResourceSchema("volumes"),
+				"volume_snapshots": associatedResourceSchema("volume snapshots"),
+			},
+		},
+	}
+}
+
+// validateDestroyAssociatedResources rejects mixing the "all" sentinel with
+// resource IDs.
+func validateDestroyAssociatedResources() schema.CustomizeDiffFunc {
+	return schema.CustomizeDiffFunc(func(ctx context.Context, diff *schema.ResourceDiff, v interface{}) error {
+		for _, key := range destroyAssociatedResourceKeys {
+			ids := diff.Get(key).(*schema.Set)
+			if ids.Contains(allAssociatedResources) && ids.Len() > 1 {
+				return fmt.Errorf("%s must either be [\"%s\"] or a list of IDs", key, allAssociatedResources)
+			}
+		}
+
+		return nil
+	})
+}
+
+func flattenAssociatedResources(list *goApiAbrha.KubernetesAssociatedResources) []interface{} {
+	return []interface{}{
+		map[string]interface{}{
+			"load_balancers":   flattenAssociatedResourceList(list.LoadBalancers),
+			"volumes":          flattenAssociatedResourceList(list.Volumes),
+			"volume_snapshots": flattenAssociatedResourceList(list.VolumeSnapshots),
+		},
+	}
+}
+
+func flattenAssociatedResourceList(resources []*goApiAbrha.AssociatedResource) []interface{} {
+	flattened := make([]interface{}, 0, len(resources))
+	for _, r := range resources {
+		flattened = append(flattened, map[string]interface{}{
+			"id":   r.ID,
+			"name": r.Name,
+		})
+	}
+
+	return flattened
+}
+
+// hasDestroyAssociatedResources reports whether any associated resources are
+// selected for deletion along with the cluster.
+func hasDestroyAssociatedResources(d *schema.ResourceData) bool {
+	for _, key := range destroyAssociatedResourceKeys {
+		if d.Get(key).(*schema.Set).Len() > 0 {
+			return true
+		}
+	}
+
+	return false
+}
+
+// expandDestroySelectiveRequest builds the request for destroying a cluster
+// with the associated resources selected in d, resolving "all" against the
+// resources currently associated with the cluster.
+func expandDestroySelectiveRequest(d *schema.ResourceData, list *goApiAbrha.KubernetesAssociatedResources) *goApiAbrha.KubernetesClusterDeleteSelectiveRequest {
+	expand := func(key string, available []*goApiAbrha.AssociatedResource) []string {
+		ids := d.Get(key).(*schema.Set)
+		if ids.Len() == 0 {
+			return []string{}
+		}
+
+		if ids.Contains(allAssociatedResources) {
+			all := make([]string, 0, len(available))
+			for _, r := range available {
+				all = append(all, r.ID)
+			}
+			return all
+		}
+
+		expanded := make([]string, 0, ids.Len())
+		for _, id := range ids.List() {
+			expanded = append(expanded, id.(string))
+		}
+		return expanded
+	}
+
+	return &goApiAbrha.KubernetesClusterDeleteSelectiveRequest{
+		LoadBalancers:   expand("destroy_associated_load_balancers", list.LoadBalancers),
+		Volumes:         expand("destroy_associated_volumes", list.Volumes),
+		VolumeSnapshots: expand("destroy_associated_volume_snapshots", list.VolumeSnapshots),
+	}
+}
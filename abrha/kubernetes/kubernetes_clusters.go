@@ -0,0 +1,159 @@
+package kubernetes
+
+import (
+	"context"
+	"fmt"
+
+	goApiAbrha "github.com/abrhacom/go-api-abrha"
+	"github.com/abrhacom/terraform-provider-abrha/abrha/config"
+	"github.com/abrhacom/terraform-provider-abrha/abrha/tag"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/schema"
+)
+
+func kubernetesClusterSchema() map[string]*schema.Schema {
+	return map[string]*schema.Schema{
+		"id": {
+			Type:        schema.TypeString,
+			Description: "id of the Kubernetes cluster",
+		},
+		"name": {
+			Type:        schema.TypeString,
+			Description: "name of the Kubernetes cluster",
+		},
+		"urn": {
+			Type:        schema.TypeString,
+			Description: "the uniform resource name for the Kubernetes cluster",
+		},
+		"region": {
+			Type:        schema.TypeString,
+			Description: "the region that the Kubernetes cluster is deployed in",
+		},
+		"version": {
+			Type:        schema.TypeString,
+			Description: "the Kubernetes version of the cluster",
+		},
+		"cluster_subnet": {
+			Type:        schema.TypeString,
+			Description: "the range of IP addresses in the overlay network of the cluster",
+		},
+		"service_subnet": {
+			Type:        schema.TypeString,
+			Description: "the range of assignable IP addresses for services in the cluster",
+		},
+		"ipv4_address": {
+			Type:        schema.TypeString,
+			Description: "the public ipv4 address of the Kubernetes master node",
+		},
+		"endpoint": {
+			Type:        schema.TypeString,
+			Description: "the base URL of the API server of the cluster",
+		},
+		"vpc_uuid": {
+			Type:        schema.TypeString,
+			Description: "UUID of the VPC in which the cluster is located",
+		},
+		"ha": {
+			Type:        schema.TypeBool,
+			Description: "whether the control plane is highly available",
+		},
+		"auto_upgrade": {
+			Type:        schema.TypeBool,
+			Description: "whether the cluster is automatically upgraded to new patch releases",
+		},
+		"surge_upgrade": {
+			Type:        schema.TypeBool,
+			Description: "whether surge upgrades are enabled for the cluster",
+		},
+		"status": {
+			Type:        schema.TypeString,
+			Description: "the status of the Kubernetes cluster",
+		},
+		"node_pool_names": {
+			Type:        schema.TypeList,
+			Elem:        &schema.Schema{Type: schema.TypeString},
+			Description: "the names of the node pools of the cluster",
+		},
+		"created_at": {
+			Type:        schema.TypeString,
+			Description: "the creation date of the Kubernetes cluster",
+		},
+		"updated_at": {
+			Type:        schema.TypeString,
+			Description: "the date the Kubernetes cluster was last updated",
+		},
+		"tags": tag.TagsDataSourceSchema(),
+	}
+}
+
+func getAbrhaKubernetesClusters(meta interface{}, extra map[string]interface{}) ([]interface{}, error) {
+	client := meta.(*config.CombinedConfig).GoApiAbrhaClient()
+
+	opts := &goApiAbrha.ListOptions{
+		Page:    1,
+		PerPage: 200,
+	}
+
+	var clusterList []interface{}
+
+	for {
+		clusters, resp, err := client.Kubernetes.List(context.Background(), opts)
+		if err != nil {
+			return nil, fmt.Errorf("Error retrieving Kubernetes clusters: %s", err)
+		}
+
+		for _, cluster := range clusters {
+			clusterList = append(clusterList, cluster)
+		}
+
+		if resp.Links == nil || resp.Links.IsLastPage() {
+			break
+		}
+
+		page, err := resp.Links.CurrentPage()
+		if err != nil {
+			return nil, fmt.Errorf("Error retrieving Kubernetes clusters: %s", err)
+		}
+
+		opts.Page = page + 1
+	}
+
+	return clusterList, nil
+}
+
+func flattenAbrhaKubernetesCluster(rawCluster, meta interface{}, extra map[string]interface{}) (map[string]interface{}, error) {
+	cluster := rawCluster.(*goApiAbrha.KubernetesCluster)
+
+	flattenedCluster := map[string]interface{}{
+		"id":             cluster.ID,
+		"name":           cluster.Name,
+		"urn":            cluster.URN(),
+		"region":         cluster.RegionSlug,
+		"version":        cluster.VersionSlug,
+		"cluster_subnet": cluster.ClusterSubnet,
+		"service_subnet": cluster.ServiceSubnet,
+		"ipv4_address":   cluster.IPv4,
+		"endpoint":       cluster.Endpoint,
+		"vpc_uuid":       cluster.VPCUUID,
+		"ha":             cluster.HA,
+		"auto_upgrade":   cluster.AutoUpgrade,
+		"surge_upgrade":  cluster.SurgeUpgrade,
+		"created_at":     cluster.CreatedAt.UTC().String(),
+		"updated_at":     cluster.UpdatedAt.UTC().String(),
+	}
+
+	if cluster.Status != nil {
+		flattenedCluster["status"] = string(cluster.Status.State)
+	}
+
+	nodePoolNames := make([]interface{}, 0, len(cluster.NodePools))
+	for _, pool := range cluster.NodePools {
+		if pool != nil {
+			nodePoolNames = append(nodePoolNames, pool.Name)
+		}
+	}
+	flattenedCluster["node_pool_names"] = nodePoolNames
+
+	flattenedCluster["tags"] = tag.FlattenTags(FilterTags(cluster.Tags))
+
+	return flattenedCluster, nil
+}
@@ -0,0 +1,72 @@
+package kubernetes
+
+import (
+	"context"
+	"fmt"
+	"time"
+
+	goApiAbrha "github.com/abrhacom/go-api-abrha"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/schema"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/validation"
+)
+
+func kubeConfigExpirySecondsSchema() *schema.Schema {
+	return &schema.Schema{
+		Type:         schema.TypeInt,
+		Optional:     true,
+		ValidateFunc: validation.IntAtLeast(1),
+		Description:  "The number of seconds the credentials in kube_config are valid for. The API default lifetime is used when unset.",
+	}
+}
+
+func kubeConfigRefreshThresholdSecondsSchema() *schema.Schema {
+	return &schema.Schema{
+		Type:         schema.TypeInt,
+		Optional:     true,
+		Default:      0,
+		ValidateFunc: validation.IntAtLeast(0),
+		Description:  "The credentials in kube_config are kept in state and only fetched again on refresh once they expire within this number of seconds",
+	}
+}
+
+func expandKubernetesCredentialsRequest(expirySeconds int) *goApiAbrha.KubernetesClusterCredentialsGetRequest {
+	req := &goApiAbrha.KubernetesClusterCredentialsGetRequest{}
+	if expirySeconds > 0 {
+		req.ExpirySeconds = goApiAbrha.PtrTo(expirySeconds)
+	}
+
+	return req
+}
+
+// planKubeConfigRefresh marks kube_config as changing when the lifetime of
+// its credentials is changed, as new credentials are fetched on the next read.
+func planKubeConfigRefresh() schema.CustomizeDiffFunc {
+	return schema.CustomizeDiffFunc(func(ctx context.Context, diff *schema.ResourceDiff, v interface{}) error {
+		if diff.Id() == "" || !diff.HasChange("kube_config_expiry_seconds") {
+			return nil
+		}
+
+		return diff.SetNewComputed("kube_config")
+	})
+}
+
+// kubeConfigRefreshDue reports whether the credentials of a kube_config
+// block must be fetched again because they are missing or expire within
+// threshold. Credentials already in state are reused until then.
+func kubeConfigRefreshDue(kubeConfig []interface{}, threshold time.Duration, now time.Time) (bool, error) {
+	if len(kubeConfig) == 0 || kubeConfig[0] == nil {
+		return true, nil
+	}
+
+	expiresAt, _ := kubeConfig[0].(map[string]interface{})["expires_at"].(string)
+	if expiresAt == "" {
+		return true, nil
+	}
+
+	expiry, err := time.Parse(time.RFC3339, expiresAt)
+	if err != nil {
+		return false, fmt.Errorf("Unable to parse Kubernetes credentials expiry: %s", err)
+	}
+
+	return !now.Add(threshold).Before(expiry), nil
+}
@@ -0,0 +1,49 @@
+package kubernetes
+
+import (
+	"testing"
+	"time"
+)
+
+func TestKubeConfigRefreshDue(t *testing.T) {
+	now := time.Date(2024, 3, 10, 12, 0, 0, 0, time.UTC)
+	kubeConfig := func(expiresAt string) []interface{} {
+		return []interface{}{map[string]interface{}{"expires_at": expiresAt}}
+	}
+
+	tt := []struct {
+		name       string
+		kubeConfig []interface{}
+		threshold  time.Duration
+		expected   bool
+		err        bool
+	}{
+		{name: "no credentials", kubeConfig: nil, expected: true},
+		{name: "no expiry", kubeConfig: kubeConfig(""), expected: true},
+		{name: "valid", kubeConfig: kubeConfig("2024-03-10T13:00:00Z"), expected: false},
+		{name: "expired", kubeConfig: kubeConfig("2024-03-10T11:00:00Z"), expected: true},
+		{name: "valid beyond threshold", kubeConfig: kubeConfig("2024-03-10T13:00:00Z"), threshold: 5 * time.Minute, expected: false},
+		{name: "expiring within threshold", kubeConfig: kubeConfig("2024-03-10T12:03:00Z"), threshold: 5 * time.Minute, expected: true},
+		{name: "invalid expiry", kubeConfig: kubeConfig("tomorrow"), err: true},
+	}
+
+	for _, tc := range tt {
+		t.Run(tc.name, func(t *testing.T) {
+			got, err := kubeConfigRefreshDue(tc.kubeConfig, tc.threshold, now)
+			if tc.err {
+				if err == nil {
+					t.Fatal("expected an error")
+				}
+				return
+			}
+
+			if err != nil {
+				t.Fatalf("unexpected error: %s", err)
+			}
+
+			if got != tc.expected {
+				t.Errorf("kubeConfigRefreshDue() = %t, expected %t", got, tc.expected)
+			}
+		})
+	}
+}
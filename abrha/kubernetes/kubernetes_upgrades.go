@@ -0,0 +1,140 @@
+package kubernetes
+
+import (
+	"context"
+	"fmt"
+	"log"
+	"sort"
+
+	goApiAbrha "github.com/abrhacom/go-api-abrha"
+	"github.com/abrhacom/terraform-provider-abrha/abrha/config"
+	"github.com/hashicorp/go-version"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/schema"
+)
+
+// sortedUpgradeSlugs returns the slugs of the given versions ordered from
+// newest to oldest. Slugs that cannot be parsed are omitted.
+func sortedUpgradeSlugs(upgrades []*goApiAbrha.KubernetesVersion) []string {
+	versions := make([]*version.Version, 0, len(upgrades))
+	for _, u := range upgrades {
+		v, err := version.NewVersion(u.Slug)
+		if err != nil {
+			log.Printf("[WARN] Unable to parse Kubernetes version %q: %s", u.Slug, err)
+			continue
+		}
+		versions = append(versions, v)
+	}
+
+	sort.Sort(sort.Reverse(version.Collection(versions)))
+
+	slugs := make([]string, len(versions))
+	for i, v := range versions {
+		slugs[i] = v.Original()
+	}
+
+	return slugs
+}
+
+// sameMinorVersion reports whether two versions share their major and minor
+// version numbers.
+func sameMinorVersion(a, b *version.Version) bool {
+	as, bs := a.Segments(), b.Segments()
+	return len(as) >= 2 && len(bs) >= 2 && as[0] == bs[0] && as[1] == bs[1]
+}
+
+// latestPatchUpgrade returns the newest upgrade within the minor version of
+// current, or an empty string if there is none.
+func latestPatchUpgrade(current string, upgrades []*goApiAbrha.KubernetesVersion) string {
+	currentVer, err := version.NewVersion(current)
+	if err != nil {
+		return ""
+	}
+
+	for _, slug := range sortedUpgradeSlugs(upgrades) {
+		v, _ := version.NewVersion(slug)
+		if sameMinorVersion(v, currentVer) && v.GreaterThan(currentVer) {
+			return slug
+		}
+	}
+
+	return ""
+}
+
+// suppressOlderPatchVersion keeps a cluster that was moved to a newer patch by
+// upgrade_to_latest_patch from planning a downgrade back to the configured
+// patch of the same minor version.
+func suppressOlderPatchVersion(k, old, new string, d *schema.ResourceData) bool {
+	if !d.Get("upgrade_to_latest_patch").(bool) || old == "" || new == "" {
+		return false
+	}
+
+	oldVer, err := version.NewVersion(old)
+	if err != nil {
+		return false
+	}
+
+	newVer, err := version.NewVersion(new)
+	if err != nil {
+		return false
+	}
+
+	return sameMinorVersion(oldVer, newVer) && !newVer.GreaterThan(oldVer)
+}
+
+// planKubernetesClusterUpgrade plans an upgrade of an existing cluster to the
+// latest patch of its minor version in latest_patch_version when
+// upgrade_to_latest_patch is set, and validates that a requested version
+// upgrade is offered for the cluster.
+func planKubernetesClusterUpgrade() schema.CustomizeDiffFunc {
+	return schema.CustomizeDiffFunc(func(ctx context.Context, diff *schema.ResourceDiff, v interface{}) error {
+		if diff.Id() == "" || !diff.NewValueKnown("version") {
+			return nil
+		}
+
+		autoPatch := diff.Get("upgrade_to_latest_patch").(bool)
+		if !autoPatch && !diff.HasChange("version") {
+			return nil
+		}
+
+		oldRaw, newRaw := diff.GetChange("version")
+		current, target := oldRaw.(string), newRaw.(string)
+
+		if diff.HasChange("version") {
+			oldVer, oldErr := version.NewVersion(current)
+			newVer, newErr := version.NewVersion(target)
+			// Downgrades recreate the cluster and are not upgrades.
+			if oldErr == nil && newErr == nil && newVer.LessThan(oldVer) {
+				return nil
+			}
+		}
+
+		client := v.(*config.CombinedConfig).GoApiAbrhaClient()
+		upgrades, _, err := client.Kubernetes.GetUpgrades(ctx, diff.Id())
+		if err != nil {
+			log.Printf("[WARN] Unable to retrieve Kubernetes cluster upgrades, skipping plan-time upgrade validation: %s", err)
+			return nil
+		}
+
+		if !diff.HasChange("version") {
+			if latest := latestPatchUpgrade(current, upgrades); latest != "" {
+				log.Printf("[INFO] Planning upgrade of Kubernetes cluster (%s) from %s to latest patch %s", diff.Id(), current, latest)
+				return diff.SetNew("latest_patch_version", latest)
+			}
+
+			return nil
+		}
+
+		for _, u := range upgrades {
+			if u.Slug == target {
+				return nil
+			}
+		}
+
+		available := sortedUpgradeSlugs(upgrades)
+		if len(available) == 0 {
+			return fmt.Errorf("Kubernetes cluster cannot be upgraded from version %s to %s: no upgrades are available", current, target)
+		}
+
+		return fmt.Errorf("Kubernetes cluster cannot be upgraded from version %s to %s, available upgrades: %v", current, target, available)
+	})
+}
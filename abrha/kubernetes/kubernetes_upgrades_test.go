@@ -0,0 +1,69 @@
+package kubernetes
+
+import (
+	"testing"
+
+	goApiAbrha "github.com/abrhacom/go-api-abrha"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/schema"
+)
+
+func TestLatestPatchUpgrade(t *testing.T) {
+	upgrades := []*goApiAbrha.KubernetesVersion{
+		{Slug: "1.29.1-do.0"},
+		{Slug: "1.29.3-do.0"},
+		{Slug: "1.29.2-do.0"},
+		{Slug: "1.30.0-do.0"},
+		{Slug: "not-a-version"},
+	}
+
+	tt := []struct {
+		name     string
+		current  string
+		upgrades []*goApiAbrha.KubernetesVersion
+		expected string
+	}{
+		{name: "newer patch available", current: "1.29.0-do.0", upgrades: upgrades, expected: "1.29.3-do.0"},
+		{name: "already on latest patch", current: "1.29.3-do.0", upgrades: upgrades, expected: ""},
+		{name: "only newer minor available", current: "1.30.0-do.0", upgrades: upgrades, expected: ""},
+		{name: "no upgrades", current: "1.29.0-do.0", upgrades: nil, expected: ""},
+		{name: "invalid current version", current: "latest", upgrades: upgrades, expected: ""},
+	}
+
+	for _, tc := range tt {
+		t.Run(tc.name, func(t *testing.T) {
+			if got := latestPatchUpgrade(tc.current, tc.upgrades); got != tc.expected {
+				t.Errorf("latestPatchUpgrade(%q) = %q, expected %q", tc.current, got, tc.expected)
+			}
+		})
+	}
+}
+
+func TestSuppressOlderPatchVersion(t *testing.T) {
+	tt := []struct {
+		name      string
+		autoPatch bool
+		old       string
+		new       string
+		expected  bool
+	}{
+		{name: "older patch with auto patch", autoPatch: true, old: "1.29.3-do.0", new: "1.29.1-do.0", expected: true},
+		{name: "same version with auto patch", autoPatch: true, old: "1.29.3-do.0", new: "1.29.3-do.0", expected: true},
+		{name: "newer patch with auto patch", autoPatch: true, old: "1.29.1-do.0", new: "1.29.3-do.0", expected: false},
+		{name: "older minor with auto patch", autoPatch: true, old: "1.30.0-do.0", new: "1.29.3-do.0", expected: false},
+		{name: "older patch without auto patch", autoPatch: false, old: "1.29.3-do.0", new: "1.29.1-do.0", expected: false},
+		{name: "new cluster", autoPatch: true, old: "", new: "1.29.1-do.0", expected: false},
+		{name: "invalid version", autoPatch: true, old: "1.29.3-do.0", new: "latest", expected: false},
+	}
+
+	for _, tc := range tt {
+		t.Run(tc.name, func(t *testing.T) {
+			d := schema.TestResourceDataRaw(t, ResourceAbrhaKubernetesCluster().Schema, map[string]interface{}{
+				"upgrade_to_latest_patch": tc.autoPatch,
+			})
+
+			if got := suppressOlderPatchVersion("version", tc.old, tc.new, d); got != tc.expected {
+				t.Errorf("suppressOlderPatchVersion(%q, %q) = %t, expected %t", tc.old, tc.new, got, tc.expected)
+			}
+		})
+	}
+}
@@ -0,0 +1,164 @@
+package kubernetes
+
+import (
+	"context"
+	"fmt"
+	"log"
+	"sort"
+	"time"
+
+	goApiAbrha "github.com/abrhacom/go-api-abrha"
+	"github.com/abrhacom/terraform-provider-abrha/abrha/config"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/diag"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/retry"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/schema"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/validation"
+)
+
+func ResourceAbrhaKubernetesAddons() *schema.Resource {
+	return &schema.Resource{
+		CreateContext: resourceAbrhaKubernetesAddonsCreate,
+		ReadContext:   resourceAbrhaKubernetesAddonsRead,
+		UpdateContext: resourceAbrhaKubernetesAddonsUpdate,
+		DeleteContext: resourceAbrhaKubernetesAddonsDelete,
+
+		Schema: map[string]*schema.Schema{
+			"cluster_uuid": {
+				Type:         schema.TypeString,
+				Required:     true,
+				ForceNew:     true,
+				ValidateFunc: validation.NoZeroValues,
+				Description:  "The ID of the Kubernetes cluster to install the add-ons into",
+			},
+
+			"addon_slugs": {
+				Type:     schema.TypeSet,
+				Required: true,
+				MinItems: 1,
+				Elem: &schema.Schema{
+					Type:         schema.TypeString,
+					ValidateFunc: validation.NoZeroValues,
+				},
+				Description: "The slugs of the 1-click add-ons to install. Removing a slug does not uninstall the add-on from the cluster.",
+			},
+		},
+
+		Timeouts: &schema.ResourceTimeout{
+			Create: schema.DefaultTimeout(30 * time.Minute),
+		},
+	}
+}
+
+func resourceAbrhaKubernetesAddonsCreate(ctx context.Context, d *schema.ResourceData, meta interface{}) diag.Diagnostics {
+	client := meta.(*config.CombinedConfig).GoApiAbrhaClient()
+	clusterID := d.Get("cluster_uuid").(string)
+
+	// Add-ons can only be installed once the cluster is running, which may
+	// not be the case yet for a cluster created in the same apply.
+	if err := waitForKubernetesClusterRunning(ctx, client, clusterID, d.Timeout(schema.TimeoutCreate)); err != nil {
+		return diag.Errorf("Error waiting for Kubernetes cluster (%s) to be running: %s", clusterID, err)
+	}
+
+	if err := installKubernetesAddons(ctx, client, clusterID, expandAddonSlugs(d.Get("addon_slugs").(*schema.Set))); err != nil {
+		return diag.FromErr(err)
+	}
+
+	d.SetId(fmt.Sprintf("%s/addons", clusterID))
+
+	return resourceAbrhaKubernetesAddonsRead(ctx, d, meta)
+}
+
+func resourceAbrhaKubernetesAddonsRead(ctx context.Context, d *schema.ResourceData, meta interface{}) diag.Diagnostics {
+	client := meta.(*config.CombinedConfig).GoApiAbrhaClient()
+	clusterID := d.Get("cluster_uuid").(string)
+
+	// Installed add-ons are not returned by the API, so only the existence
+	// of the cluster can be checked.
+	_, resp, err := client.Kubernetes.Get(ctx, clusterID)
+	if err != nil {
+		if resp != nil && resp.StatusCode == 404 {
+			log.Printf("[WARN] Kubernetes cluster (%s) not found, removing add-ons from state", clusterID)
+			d.SetId("")
+			return nil
+		}
+
+		return diag.Errorf("Error retrieving Kubernetes cluster: %s", err)
+	}
+
+	return nil
+}
+
+func resourceAbrhaKubernetesAddonsUpdate(ctx context.Context, d *schema.ResourceData, meta interface{}) diag.Diagnostics {
+	client := meta.(*config.CombinedConfig).GoApiAbrhaClient()
+
+	if d.HasChange("addon_slugs") {
+		oldRaw, newRaw := d.GetChange("addon_slugs")
+		oldSlugs, newSlugs := oldRaw.(*schema.Set), newRaw.(*schema.Set)
+
+		if removed := expandAddonSlugs(oldSlugs.Difference(newSlugs)); len(removed) > 0 {
+			log.Printf("[WARN] Add-ons %v are no longer managed but remain installed in Kubernetes cluster (%s)", removed, d.Get("cluster_uuid").(string))
+		}
+
+		if added := expandAddonSlugs(newSlugs.Difference(oldSlugs)); len(added) > 0 {
+			if err := installKubernetesAddons(ctx, client, d.Get("cluster_uuid").(string), added); err != nil {
+				return diag.FromErr(err)
+			}
+		}
+	}
+
+	return resourceAbrhaKubernetesAddonsRead(ctx, d, meta)
+}
+
+func resourceAbrhaKubernetesAddonsDelete(ctx context.Context, d *schema.ResourceData, meta interface{}) diag.Diagnostics {
+	// The API does not support uninstalling add-ons; they are removed along
+	// with the cluster.
+	log.Printf("[WARN] Kubernetes add-ons cannot be uninstalled, removing from state only")
+	d.SetId("")
+	return nil
+}
+
+func installKubernetesAddons(ctx context.Context, client *goApiAbrha.Client, clusterID string, slugs []string) error {
+	log.Printf("[INFO] Installing add-ons %v into Kubernetes cluster (%s)", slugs, clusterID)
+	_, _, err := client.OneClick.InstallKubernetes(ctx, &goApiAbrha.InstallKubernetesAppsRequest{
+		Slugs:       slugs,
+		ClusterUUID: clusterID,
+	})
+	if err != nil {
+		return fmt.Errorf("Error installing add-ons into Kubernetes cluster (%s): %s", clusterID, err)
+	}
+
+	return nil
+}
+
+func expandAddonSlugs(slugs *schema.Set) []string {
+	expanded := make([]string, 0, slugs.Len())
+	for _, slug := range slugs.List() {
+		expanded = append(expanded, slug.(string))
+	}
+
+	sort.Strings(expanded)
+
+	return expanded
+}
+
+// waitForKubernetesClusterRunning waits until a Kubernetes cluster reports
+// the running state.
+func waitForKubernetesClusterRunning(ctx context.Context, client *goApiAbrha.Client, clusterID string, timeout time.Duration) error {
+	stateConf := &retry.StateChangeConf{
+		Pending: []string{"provisioning", "upgrading", "degraded"},
+		Target:  []string{"running"},
+		Refresh: func() (interface{}, string, error) {
+			cluster, _, err := client.Kubernetes.Get(ctx, clusterID)
+			if err != nil {
+				return nil, "", fmt.Errorf("Error trying to read cluster state: %s", err)
+			}
+
+			return cluster, string(cluster.Status.State), nil
+		},
+		Timeout:    timeout,
+		MinTimeout: 10 * time.Second,
+	}
+
+	_, err := stateConf.WaitForStateContext(ctx)
+	return err
+}
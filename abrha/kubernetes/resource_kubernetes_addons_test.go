@@ -0,0 +1,61 @@
+package kubernetes_test
+
+import (
+	"fmt"
+	"testing"
+
+	goApiAbrha "github.com/abrhacom/go-api-abrha"
+	"github.com/abrhacom/terraform-provider-abrha/abrha/acceptance"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/resource"
+)
+
+func TestAccAbrhaKubernetesAddons_Basic(t *testing.T) {
+	rName := acceptance.RandomTestName()
+	var k8s goApiAbrha.KubernetesCluster
+
+	resource.ParallelTest(t, resource.TestCase{
+		PreCheck:          func() { acceptance.TestAccPreCheck(t) },
+		ProviderFactories: acceptance.TestAccProviderFactories,
+		CheckDestroy:      testAccCheckAbrhaKubernetesClusterDestroy,
+		Steps: []resource.TestStep{
+			{
+				Config: testAccAbrhaKubernetesConfigAddons(rName, `"monitoring"`),
+				Check: resource.ComposeTestCheckFunc(
+					testAccCheckAbrhaKubernetesClusterExists("abrha_kubernetes_cluster.foobar", &k8s),
+					resource.TestCheckResourceAttrPair("abrha_kubernetes_addons.foobar", "cluster_uuid", "abrha_kubernetes_cluster.foobar", "id"),
+					resource.TestCheckResourceAttr("abrha_kubernetes_addons.foobar", "addon_slugs.#", "1"),
+					resource.TestCheckTypeSetElemAttr("abrha_kubernetes_addons.foobar", "addon_slugs.*", "monitoring"),
+				),
+			},
+			{
+				Config: testAccAbrhaKubernetesConfigAddons(rName, `"monitoring", "ingress-nginx"`),
+				Check: resource.ComposeTestCheckFunc(
+					resource.TestCheckResourceAttr("abrha_kubernetes_addons.foobar", "addon_slugs.#", "2"),
+					resource.TestCheckTypeSetElemAttr("abrha_kubernetes_addons.foobar", "addon_slugs.*", "ingress-nginx"),
+				),
+			},
+		},
+	})
+}
+
+func testAccAbrhaKubernetesConfigAddons(rName, slugs string) string {
+	return fmt.Sprintf(`%s
+
+resource "abrha_kubernetes_cluster" "foobar" {
+  name    = "%s"
+  region  = "lon1"
+  version = data.abrha_kubernetes_versions.test.latest_version
+
+  node_pool {
+    name       = "default"
+    size       = "s-2vcpu-4gb"
+    node_count = 1
+  }
+}
+
+resource "abrha_kubernetes_addons" "foobar" {
+  cluster_uuid = abrha_kubernetes_cluster.foobar.id
+  addon_slugs  = [%s]
+}
+`, testClusterVersionLatest, rName, slugs)
+}
@@ -72,9 +72,23 @@ func ResourceAbrhaKubernetesCluster() *schema.Resource {
 			},
 
 			"version": {
-				Type:         schema.TypeString,
-				Required:     true,
-				ValidateFunc: validation.NoZeroValues,
+				Type:             schema.TypeString,
+				Required:         true,
+				ValidateFunc:     validation.NoZeroValues,
+				DiffSuppressFunc: suppressOlderPatchVersion,
+			},
+
+			"upgrade_to_latest_patch": {
+				Type:        schema.TypeBool,
+				Optional:    true,
+				Default:     false,
+				Description: "Whether to upgrade the cluster to the latest patch release of its current minor version when one becomes available",
+			},
+
+			"latest_patch_version": {
+				Type:        schema.TypeString,
+				Computed:    true,
+				Description: "The patch release the cluster was last upgraded to by upgrade_to_latest_patch",
 			},
 
 			"vpc_uuid": {
@@ -165,6 +179,10 @@ func ResourceAbrhaKubernetesCluster() *schema.Resource {
 
 			"kube_config": kubernetesConfigSchema(),
 
+			"kube_config_expiry_seconds": kubeConfigExpirySecondsSchema(),
+
+			"kube_config_refresh_threshold_seconds": kubeConfigRefreshThresholdSecondsSchema(),
+
 			"auto_upgrade": {
 				Type:     schema.TypeBool,
 				Optional: true,
@@ -180,6 +198,14 @@ func ResourceAbrhaKubernetesCluster() *schema.Resource {
 				Optional: true,
 				Default:  false,
 			},
+
+			"destroy_associated_load_balancers": destroyAssociatedResourcesSchema("load balancers"),
+
+			"destroy_associated_volumes": destroyAssociatedResourcesSchema("volumes"),
+
+			"destroy_associated_volume_snapshots": destroyAssociatedResourcesSchema("volume snapshots"),
+
+			"associated_resources": associatedResourcesSchema(),
 		},
 
 		Timeouts: &schema.ResourceTimeout{
@@ -205,6 +231,9 @@ func ResourceAbrhaKubernetesCluster() *schema.Resource {
 				}
 				return false
 			}),
+			planKubernetesClusterUpgrade(),
+			validateDestroyAssociatedResources(),
+			planKubeConfigRefresh(),
 		),
 	}
 }
@@ -347,7 +376,28 @@ func resourceAbrhaKubernetesClusterRead(ctx context.Context, d *schema.ResourceD
 		return diag.Errorf("Error retrieving Kubernetes cluster: %s", err)
 	}
 
-	return parspackKubernetesClusterRead(client, cluster, d)
+	if diags := parspackKubernetesClusterRead(client, cluster, d); diags.HasError() {
+		return diags
+	}
+
+	// Only list the associated resources when some are selected for deletion,
+	// to avoid an extra request on every refresh.
+	if !hasDestroyAssociatedResources(d) {
+		d.Set("associated_resources", nil)
+		return nil
+	}
+
+	list, _, err := client.Kubernetes.ListAssociatedResourcesForDeletion(ctx, d.Id())
+	if err != nil {
+		log.Printf("[WARN] Unable to list resources associated with Kubernetes cluster (%s): %s", d.Id(), err)
+		return nil
+	}
+
+	if err := d.Set("associated_resources", flattenAssociatedResources(list)); err != nil {
+		return diag.Errorf("Error setting associated_resources: %s", err)
+	}
+
+	return nil
 }
 
 func parspackKubernetesClusterRead(
@@ -399,21 +449,21 @@ func parspackKubernetesClusterRead(
 		log.Printf("[WARN] No default node pool was found. The default node pool must have the `%s` tag if created with Terraform.", ParspackKubernetesDefaultNodePoolTag)
 	}
 
-	// fetch cluster credentials and update the resource if the credentials are expired.
-	var creds map[string]interface{}
-	if d.Get("kube_config") != nil && len(d.Get("kube_config").([]interface{})) > 0 {
-		creds = d.Get("kube_config").([]interface{})[0].(map[string]interface{})
+	// fetch cluster credentials and update the resource if the credentials are expired,
+	// or about to expire when a refresh threshold is set. The threshold is not part of
+	// the data source schema, as data sources keep no credentials between reads.
+	var threshold time.Duration
+	if v, ok := d.GetOk("kube_config_refresh_threshold_seconds"); ok {
+		threshold = time.Duration(v.(int)) * time.Second
 	}
-	var expiresAt time.Time
-	if creds["expires_at"] != nil && creds["expires_at"].(string) != "" {
-		var err error
-		expiresAt, err = time.Parse(time.RFC3339, creds["expires_at"].(string))
-		if err != nil {
-			return diag.Errorf("Unable to parse Kubernetes credentials expiry: %s", err)
-		}
+	kubeConfig, _ := d.Get("kube_config").([]interface{})
+	refresh, err := kubeConfigRefreshDue(kubeConfig, threshold, time.Now())
+	if err != nil {
+		return diag.FromErr(err)
 	}
-	if expiresAt.IsZero() || expiresAt.Before(time.Now()) {
-		creds, _, err := client.Kubernetes.GetCredentials(context.Background(), cluster.ID, &goApiAbrha.KubernetesClusterCredentialsGetRequest{})
+	if refresh || d.HasChange("kube_config_expiry_seconds") {
+		credsReq := expandKubernetesCredentialsRequest(d.Get("kube_config_expiry_seconds").(int))
+		creds, _, err := client.Kubernetes.GetCredentials(context.Background(), cluster.ID, credsReq)
 		if err != nil {
 			return diag.Errorf("Unable to fetch Kubernetes credentials: %s", err)
 		}
@@ -475,9 +525,16 @@ func resourceAbrhaKubernetesClusterUpdate(ctx context.Context, d *schema.Resourc
 		}
 	}
 
+	upgradeVersion := ""
 	if d.HasChange("version") {
+		upgradeVersion = d.Get("version").(string)
+	} else if d.HasChange("latest_patch_version") {
+		upgradeVersion = d.Get("latest_patch_version").(string)
+	}
+
+	if upgradeVersion != "" {
 		opts := &goApiAbrha.KubernetesClusterUpgradeRequest{
-			VersionSlug: d.Get("version").(string),
+			VersionSlug: upgradeVersion,
 		}
 
 		_, err := client.Kubernetes.Upgrade(context.Background(), d.Id(), opts)
@@ -524,6 +581,24 @@ func resourceAbrhaKubernetesClusterDelete(ctx context.Context, d *schema.Resourc
 				return nil
 			}
 
+			return diag.Errorf("Unable to delete cluster: %s", err)
+		}
+	} else if hasDestroyAssociatedResources(d) {
+		list, _, err := client.Kubernetes.ListAssociatedResourcesForDeletion(ctx, d.Id())
+		if err != nil {
+			return diag.Errorf("Failed to list associated resources: %s", err)
+		}
+
+		req := expandDestroySelectiveRequest(d, list)
+		log.Printf("[WARN] The following resources associated with the cluster will be destroyed: %s", goApiAbrha.Stringify(req))
+
+		resp, err := client.Kubernetes.DeleteSelective(ctx, d.Id(), req)
+		if err != nil {
+			if resp != nil && resp.StatusCode == 404 {
+				d.SetId("")
+				return nil
+			}
+
 			return diag.Errorf("Unable to delete cluster: %s", err)
 		}
 	} else {
@@ -94,6 +94,7 @@ func TestAccAbrhaKubernetesCluster_Basic(t *testing.T) {
 					resource.TestCheckResourceAttrSet("abrha_kubernetes_cluster.foobar", "maintenance_policy.0.start_time"),
 					resource.TestCheckResourceAttr("abrha_kubernetes_cluster.foobar", "registry_integration", "false"),
 					resource.TestCheckResourceAttr("abrha_kubernetes_cluster.foobar", "destroy_all_associated_resources", "false"),
+					resource.TestCheckResourceAttr("abrha_kubernetes_cluster.foobar", "associated_resources.#", "0"),
 				),
 			},
 			// Update: remove default node_pool taints
@@ -738,6 +739,29 @@ func TestAccAbrhaKubernetesCluster_UpgradeVersion(t *testing.T) {
 	})
 }
 
+func TestAccAbrhaKubernetesCluster_UnavailableUpgrade(t *testing.T) {
+	rName := acceptance.RandomTestName()
+	var k8s goApiAbrha.KubernetesCluster
+
+	resource.ParallelTest(t, resource.TestCase{
+		PreCheck:          func() { acceptance.TestAccPreCheck(t) },
+		ProviderFactories: acceptance.TestAccProviderFactories,
+		CheckDestroy:      testAccCheckAbrhaKubernetesClusterDestroy,
+		Steps: []resource.TestStep{
+			{
+				Config: testAccAbrhaKubernetesConfigBasic(testClusterVersionLatest, rName),
+				Check: resource.ComposeAggregateTestCheckFunc(
+					testAccCheckAbrhaKubernetesClusterExists("abrha_kubernetes_cluster.foobar", &k8s),
+				),
+			},
+			{
+				Config:      testAccAbrhaKubernetesConfigVersion(rName, "99.0.0-do.0"),
+				ExpectError: regexp.MustCompile("Kubernetes cluster cannot be upgraded from version"),
+			},
+		},
+	})
+}
+
 func TestAccAbrhaKubernetesCluster_DestroyAssociated(t *testing.T) {
 	rName := acceptance.RandomTestName()
 	var k8s goApiAbrha.KubernetesCluster
@@ -759,6 +783,63 @@ func TestAccAbrhaKubernetesCluster_DestroyAssociated(t *testing.T) {
 	})
 }
 
+func TestAccAbrhaKubernetesCluster_DestroySelective(t *testing.T) {
+	rName := acceptance.RandomTestName()
+	var k8s goApiAbrha.KubernetesCluster
+
+	resource.ParallelTest(t, resource.TestCase{
+		PreCheck:          func() { acceptance.TestAccPreCheck(t) },
+		ProviderFactories: acceptance.TestAccProviderFactories,
+		CheckDestroy:      testAccCheckAbrhaKubernetesClusterDestroy,
+		Steps: []resource.TestStep{
+			{
+				Config: testAccAbrhaKubernetesConfigDestroySelective(testClusterVersionLatest, rName),
+				Check: resource.ComposeAggregateTestCheckFunc(
+					testAccCheckAbrhaKubernetesClusterExists("abrha_kubernetes_cluster.foobar", &k8s),
+					resource.TestCheckResourceAttr("abrha_kubernetes_cluster.foobar", "destroy_associated_load_balancers.#", "1"),
+					resource.TestCheckTypeSetElemAttr("abrha_kubernetes_cluster.foobar", "destroy_associated_load_balancers.*", "all"),
+					resource.TestCheckResourceAttr("abrha_kubernetes_cluster.foobar", "destroy_associated_volumes.#", "0"),
+					resource.TestCheckResourceAttr("abrha_kubernetes_cluster.foobar", "associated_resources.#", "1"),
+				),
+			},
+			{
+				Config:      testAccAbrhaKubernetesConfigDestroySelectiveInvalid(testClusterVersionLatest, rName),
+				ExpectError: regexp.MustCompile(`destroy_associated_volumes must either be \["all"\] or a list of IDs`),
+			},
+		},
+	})
+}
+
+func TestAccAbrhaKubernetesCluster_KubeConfigExpiry(t *testing.T) {
+	rName := acceptance.RandomTestName()
+	var k8s goApiAbrha.KubernetesCluster
+
+	resource.ParallelTest(t, resource.TestCase{
+		PreCheck:          func() { acceptance.TestAccPreCheck(t) },
+		ProviderFactories: acceptance.TestAccProviderFactories,
+		CheckDestroy:      testAccCheckAbrhaKubernetesClusterDestroy,
+		Steps: []resource.TestStep{
+			{
+				Config: testAccAbrhaKubernetesConfigKubeConfigExpiry(testClusterVersionLatest, rName, 3600),
+				Check: resource.ComposeAggregateTestCheckFunc(
+					testAccCheckAbrhaKubernetesClusterExists("abrha_kubernetes_cluster.foobar", &k8s),
+					resource.TestCheckResourceAttr("abrha_kubernetes_cluster.foobar", "kube_config_expiry_seconds", "3600"),
+					resource.TestCheckResourceAttr("abrha_kubernetes_cluster.foobar", "kube_config_refresh_threshold_seconds", "300"),
+					resource.TestCheckResourceAttrSet("abrha_kubernetes_cluster.foobar", "kube_config.0.token"),
+					resource.TestCheckResourceAttrSet("abrha_kubernetes_cluster.foobar", "kube_config.0.expires_at"),
+				),
+			},
+			{
+				Config: testAccAbrhaKubernetesConfigKubeConfigExpiry(testClusterVersionLatest, rName, 7200),
+				Check: resource.ComposeAggregateTestCheckFunc(
+					resource.TestCheckResourceAttr("abrha_kubernetes_cluster.foobar", "kube_config_expiry_seconds", "7200"),
+					resource.TestCheckResourceAttrSet("abrha_kubernetes_cluster.foobar", "kube_config.0.expires_at"),
+				),
+			},
+		},
+	})
+}
+
 func TestAccAbrhaKubernetesCluster_VPCNative(t *testing.T) {
 	rName := acceptance.RandomTestName()
 	var k8s goApiAbrha.KubernetesCluster
@@ -810,6 +891,33 @@ resource "abrha_kubernetes_cluster" "foobar" {
 `, testClusterVersion, rName)
 }
 
+func testAccAbrhaKubernetesConfigVersion(rName string, version string) string {
+	return fmt.Sprintf(`
+resource "abrha_kubernetes_cluster" "foobar" {
+  name          = "%s"
+  region        = "nyc1"
+  version       = "%s"
+  surge_upgrade = true
+  tags          = ["foo", "bar", "one"]
+
+  node_pool {
+    name       = "default"
+    size       = "s-1vcpu-2gb"
+    node_count = 1
+    tags       = ["one", "two"]
+    labels = {
+      priority = "high"
+    }
+    taint {
+      key    = "key1"
+      value  = "val1"
+      effect = "PreferNoSchedule"
+    }
+  }
+}
+`, rName, version)
+}
+
 func testAccAbrhaKubernetesConfigMaintenancePolicy(testClusterVersion string, rName string, policy string) string {
 	return fmt.Sprintf(`%s
 
@@ -1089,3 +1197,59 @@ users:
 		t.Errorf("renderKubeconfig returned %+v\n, expected %+v\n", got, expected)
 	}
 }
+
+func testAccAbrhaKubernetesConfigDestroySelective(testClusterVersion string, rName string) string {
+	return fmt.Sprintf(`%s
+
+resource "abrha_kubernetes_cluster" "foobar" {
+  name                              = "%s"
+  region                            = "nyc1"
+  version                           = data.abrha_kubernetes_versions.test.latest_version
+  destroy_associated_load_balancers = ["all"]
+
+  node_pool {
+    name       = "default"
+    size       = "s-1vcpu-2gb"
+    node_count = 1
+  }
+}
+`, testClusterVersion, rName)
+}
+
+func testAccAbrhaKubernetesConfigDestroySelectiveInvalid(testClusterVersion string, rName string) string {
+	return fmt.Sprintf(`%s
+
+resource "abrha_kubernetes_cluster" "foobar" {
+  name                              = "%s"
+  region                            = "nyc1"
+  version                           = data.abrha_kubernetes_versions.test.latest_version
+  destroy_associated_load_balancers = ["all"]
+  destroy_associated_volumes        = ["all", "a1b2c3d4-0000-0000-0000-000000000000"]
+
+  node_pool {
+    name       = "default"
+    size       = "s-1vcpu-2gb"
+    node_count = 1
+  }
+}
+`, testClusterVersion, rName)
+}
+
+func testAccAbrhaKubernetesConfigKubeConfigExpiry(testClusterVersion string, rName string, expirySeconds int) string {
+	return fmt.Sprintf(`%s
+
+resource "abrha_kubernetes_cluster" "foobar" {
+  name                                  = "%s"
+  region                                = "nyc1"
+  version                               = data.abrha_kubernetes_versions.test.latest_version
+  kube_config_expiry_seconds            = %d
+  kube_config_refresh_threshold_seconds = 300
+
+  node_pool {
+    name       = "default"
+    size       = "s-1vcpu-2gb"
+    node_count = 1
+  }
+}
+`, testClusterVersion, rName, expirySeconds)
+}
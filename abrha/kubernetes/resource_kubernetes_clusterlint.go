@@ -0,0 +1,213 @@
+package kubernetes
+
+import (
+	"context"
+	"log"
+	"time"
+
+	goApiAbrha "github.com/abrhacom/go-api-abrha"
+	"github.com/abrhacom/terraform-provider-abrha/abrha/config"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/diag"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/schema"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/validation"
+)
+
+func ResourceAbrhaKubernetesClusterlint() *schema.Resource {
+	return &schema.Resource{
+		CreateContext: resourceAbrhaKubernetesClusterlintCreate,
+		ReadContext:   resourceAbrhaKubernetesClusterlintRead,
+		UpdateContext: resourceAbrhaKubernetesClusterlintUpdate,
+		DeleteContext: resourceAbrhaKubernetesClusterlintDelete,
+
+		Schema: map[string]*schema.Schema{
+			"cluster_id": {
+				Type:         schema.TypeString,
+				Required:     true,
+				ForceNew:     true,
+				ValidateFunc: validation.NoZeroValues,
+			},
+			"include_groups": {
+				Type:        schema.TypeSet,
+				Optional:    true,
+				Elem:        &schema.Schema{Type: schema.TypeString},
+				Description: "Only run the checks in these groups",
+			},
+			"exclude_groups": {
+				Type:        schema.TypeSet,
+				Optional:    true,
+				Elem:        &schema.Schema{Type: schema.TypeString},
+				Description: "Skip the checks in these groups",
+			},
+			"include_checks": {
+				Type:        schema.TypeSet,
+				Optional:    true,
+				Elem:        &schema.Schema{Type: schema.TypeString},
+				Description: "Only run these checks",
+			},
+			"exclude_checks": {
+				Type:        schema.TypeSet,
+				Optional:    true,
+				Elem:        &schema.Schema{Type: schema.TypeString},
+				Description: "Skip these checks",
+			},
+			"triggers": {
+				Type:        schema.TypeMap,
+				Optional:    true,
+				Elem:        &schema.Schema{Type: schema.TypeString},
+				Description: "Arbitrary map of values that, when changed, will trigger a new lint run",
+			},
+			"fail_on_severity": {
+				Type:         schema.TypeString,
+				Optional:     true,
+				ValidateFunc: validation.StringInSlice(clusterlintSeverities, false),
+				Description:  "Fail the apply if any diagnostics at or above this severity are reported",
+			},
+
+			// Computed attributes
+			"run_id": {
+				Type:     schema.TypeString,
+				Computed: true,
+			},
+			"diagnostics":     clusterlintDiagnosticsSchema(),
+			"severity_counts": clusterlintSeverityCountsSchema(),
+		},
+
+		Timeouts: &schema.ResourceTimeout{
+			Create: schema.DefaultTimeout(10 * time.Minute),
+			Update: schema.DefaultTimeout(10 * time.Minute),
+		},
+	}
+}
+
+func resourceAbrhaKubernetesClusterlintCreate(ctx context.Context, d *schema.ResourceData, meta interface{}) diag.Diagnostics {
+	client := meta.(*config.CombinedConfig).GoApiAbrhaClient()
+
+	runID, diagnostics, err := runClusterlint(ctx, client, d, d.Timeout(schema.TimeoutCreate))
+	if err != nil {
+		return diag.FromErr(err)
+	}
+
+	d.SetId(runID)
+	log.Printf("[INFO] Clusterlint run ID: %s", runID)
+	setClusterlintResults(d, runID, diagnostics)
+
+	return checkClusterlintSeverity(d, diagnostics)
+}
+
+func resourceAbrhaKubernetesClusterlintRead(ctx context.Context, d *schema.ResourceData, meta interface{}) diag.Diagnostics {
+	client := meta.(*config.CombinedConfig).GoApiAbrhaClient()
+	clusterID := d.Get("cluster_id").(string)
+
+	diagnostics, resp, err := client.Kubernetes.GetClusterlintResults(context.Background(), clusterID, &goApiAbrha.KubernetesGetClusterlintRequest{RunId: d.Id()})
+	if err != nil {
+		// The results of a run are only retained for a limited time, or the
+		// cluster may have been deleted. Either way, a new run is required.
+		if resp != nil && resp.StatusCode == 404 {
+			log.Printf("[DEBUG] Clusterlint run (%s) was not found - removing from state", d.Id())
+			d.SetId("")
+			return nil
+		}
+
+		return diag.Errorf("Error retrieving clusterlint results: %s", err)
+	}
+
+	setClusterlintResults(d, d.Id(), diagnostics)
+
+	return nil
+}
+
+func resourceAbrhaKubernetesClusterlintUpdate(ctx context.Context, d *schema.ResourceData, meta interface{}) diag.Diagnostics {
+	client := meta.(*config.CombinedConfig).GoApiAbrhaClient()
+
+	// Keep the previous state if the new run fails the severity check so that
+	// the next apply runs the linter again.
+	d.Partial(true)
+
+	if d.HasChanges("include_groups", "exclude_groups", "include_checks", "exclude_checks", "triggers") {
+		runID, diagnostics, err := runClusterlint(ctx, client, d, d.Timeout(schema.TimeoutUpdate))
+		if err != nil {
+			return diag.FromErr(err)
+		}
+
+		d.SetId(runID)
+		log.Printf("[INFO] Clusterlint run ID: %s", runID)
+		setClusterlintResults(d, runID, diagnostics)
+
+		if diags := checkClusterlintSeverity(d, diagnostics); diags.HasError() {
+			return diags
+		}
+	} else if d.HasChange("fail_on_severity") {
+		diagnostics, _, err := client.Kubernetes.GetClusterlintResults(context.Background(), d.Get("cluster_id").(string), &goApiAbrha.KubernetesGetClusterlintRequest{RunId: d.Id()})
+		if err != nil {
+			return diag.Errorf("Error retrieving clusterlint results: %s", err)
+		}
+
+		if diags := checkClusterlintSeverity(d, diagnostics); diags.HasError() {
+			return diags
+		}
+	}
+
+	d.Partial(false)
+
+	return resourceAbrhaKubernetesClusterlintRead(ctx, d, meta)
+}
+
+func resourceAbrhaKubernetesClusterlintDelete(ctx context.Context, d *schema.ResourceData, meta interface{}) diag.Diagnostics {
+	// Lint runs cannot be deleted; removing the resource only drops it from state.
+	d.SetId("")
+	return nil
+}
+
+func runClusterlint(ctx context.Context, client *goApiAbrha.Client, d *schema.ResourceData, timeout time.Duration) (string, []*goApiAbrha.ClusterlintDiagnostic, error) {
+	clusterID := d.Get("cluster_id").(string)
+
+	opts := &goApiAbrha.KubernetesRunClusterlintRequest{
+		IncludeGroups: expandStringSet(d.Get("include_groups").(*schema.Set)),
+		ExcludeGroups: expandStringSet(d.Get("exclude_groups").(*schema.Set)),
+		IncludeChecks: expandStringSet(d.Get("include_checks").(*schema.Set)),
+		ExcludeChecks: expandStringSet(d.Get("exclude_checks").(*schema.Set)),
+	}
+
+	log.Printf("[DEBUG] Clusterlint run request: %#v", opts)
+	runID, _, err := client.Kubernetes.RunClusterlint(context.Background(), clusterID, opts)
+	if err != nil {
+		return "", nil, err
+	}
+
+	diagnostics, err := waitForClusterlintResults(ctx, client, clusterID, runID, timeout)
+	if err != nil {
+		return "", nil, err
+	}
+
+	return runID, diagnostics, nil
+}
+
+func setClusterlintResults(d *schema.ResourceData, runID string, diagnostics []*goApiAbrha.ClusterlintDiagnostic) {
+	d.Set("run_id", runID)
+	d.Set("diagnostics", flattenClusterlintDiagnostics(diagnostics))
+	d.Set("severity_counts", countClusterlintSeverities(diagnostics))
+}
+
+func checkClusterlintSeverity(d *schema.ResourceData, diagnostics []*goApiAbrha.ClusterlintDiagnostic) diag.Diagnostics {
+	threshold, ok := d.GetOk("fail_on_severity")
+	if !ok {
+		return nil
+	}
+
+	failed := clusterlintDiagnosticsAtOrAbove(diagnostics, threshold.(string))
+	if len(failed) == 0 {
+		return nil
+	}
+
+	return diag.Errorf("Clusterlint run %s reported %d diagnostic(s) at or above severity %q:\n%s",
+		d.Id(), len(failed), threshold.(string), formatClusterlintDiagnostics(failed))
+}
+
+func expandStringSet(set *schema.Set) []string {
+	result := make([]string, 0, set.Len())
+	for _, v := range set.List() {
+		result = append(result, v.(string))
+	}
+
+	return result
+}
@@ -0,0 +1,54 @@
+package kubernetes_test
+
+import (
+	"fmt"
+	"testing"
+
+	"github.com/abrhacom/terraform-provider-abrha/abrha/acceptance"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/resource"
+)
+
+func TestAccAbrhaKubernetesClusterlint_Basic(t *testing.T) {
+	rName := acceptance.RandomTestName()
+	clusterConfig := testAccAbrhaKubernetesConfigBasic(testClusterVersionLatest, rName)
+
+	resource.ParallelTest(t, resource.TestCase{
+		PreCheck:          func() { acceptance.TestAccPreCheck(t) },
+		ProviderFactories: acceptance.TestAccProviderFactories,
+		CheckDestroy:      testAccCheckAbrhaKubernetesClusterDestroy,
+		Steps: []resource.TestStep{
+			{
+				Config: testAccAbrhaKubernetesClusterlintConfig(clusterConfig, "1"),
+				Check: resource.ComposeTestCheckFunc(
+					resource.TestCheckResourceAttrPair(
+						"abrha_kubernetes_clusterlint.foobar", "cluster_id", "abrha_kubernetes_cluster.foobar", "id"),
+					resource.TestCheckResourceAttrSet("abrha_kubernetes_clusterlint.foobar", "run_id"),
+					resource.TestCheckResourceAttrSet("abrha_kubernetes_clusterlint.foobar", "diagnostics.#"),
+					resource.TestCheckResourceAttrSet("abrha_kubernetes_clusterlint.foobar", "severity_counts.error"),
+				),
+			},
+			{
+				// Changing the triggers starts a new run.
+				Config: testAccAbrhaKubernetesClusterlintConfig(clusterConfig, "2"),
+				Check: resource.ComposeTestCheckFunc(
+					resource.TestCheckResourceAttr("abrha_kubernetes_clusterlint.foobar", "triggers.run", "2"),
+					resource.TestCheckResourceAttrSet("abrha_kubernetes_clusterlint.foobar", "run_id"),
+				),
+			},
+		},
+	})
+}
+
+func testAccAbrhaKubernetesClusterlintConfig(clusterConfig string, run string) string {
+	return fmt.Sprintf(`%s
+
+resource "abrha_kubernetes_clusterlint" "foobar" {
+  cluster_id     = abrha_kubernetes_cluster.foobar.id
+  exclude_groups = ["workload-health"]
+
+  triggers = {
+    run = "%s"
+  }
+}
+`, clusterConfig, run)
+}
@@ -0,0 +1,224 @@
+package kubernetes
+
+import (
+	"context"
+	"fmt"
+	"log"
+	"net/http"
+	"time"
+
+	goApiAbrha "github.com/abrhacom/go-api-abrha"
+	"github.com/abrhacom/terraform-provider-abrha/abrha/config"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/diag"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/retry"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/schema"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/validation"
+)
+
+func ResourceAbrhaKubernetesNodePoolRecycle() *schema.Resource {
+	return &schema.Resource{
+		CreateContext: resourceAbrhaKubernetesNodePoolRecycleCreate,
+		ReadContext:   resourceAbrhaKubernetesNodePoolRecycleRead,
+		DeleteContext: resourceAbrhaKubernetesNodePoolRecycleDelete,
+
+		Schema: map[string]*schema.Schema{
+			"cluster_id": {
+				Type:         schema.TypeString,
+				Required:     true,
+				ForceNew:     true,
+				ValidateFunc: validation.NoZeroValues,
+			},
+
+			"node_pool_id": {
+				Type:         schema.TypeString,
+				Required:     true,
+				ForceNew:     true,
+				ValidateFunc: validation.NoZeroValues,
+			},
+
+			"triggers": {
+				Type:        schema.TypeMap,
+				Optional:    true,
+				ForceNew:    true,
+				Elem:        &schema.Schema{Type: schema.TypeString},
+				Description: "Arbitrary values that, when changed, recycle the nodes of the pool again",
+			},
+
+			"node_ids": {
+				Type:        schema.TypeSet,
+				Optional:    true,
+				ForceNew:    true,
+				Elem:        &schema.Schema{Type: schema.TypeString},
+				Description: "The IDs of the nodes to replace. All nodes of the pool are replaced when unset.",
+			},
+
+			"max_unavailable": {
+				Type:         schema.TypeInt,
+				Optional:     true,
+				ForceNew:     true,
+				Default:      1,
+				ValidateFunc: validation.IntAtLeast(1),
+				Description:  "The number of nodes replaced at the same time",
+			},
+
+			"skip_drain": {
+				Type:        schema.TypeBool,
+				Optional:    true,
+				ForceNew:    true,
+				Default:     false,
+				Description: "Whether to skip draining the nodes before replacing them",
+			},
+
+			"recycled_node_ids": {
+				Type:        schema.TypeList,
+				Computed:    true,
+				Elem:        &schema.Schema{Type: schema.TypeString},
+				Description: "The IDs of the nodes that were replaced",
+			},
+		},
+
+		Timeouts: &schema.ResourceTimeout{
+			Create: schema.DefaultTimeout(60 * time.Minute),
+		},
+	}
+}
+
+func resourceAbrhaKubernetesNodePoolRecycleCreate(ctx context.Context, d *schema.ResourceData, meta interface{}) diag.Diagnostics {
+	client := meta.(*config.CombinedConfig).GoApiAbrhaClient()
+	clusterID := d.Get("cluster_id").(string)
+	poolID := d.Get("node_pool_id").(string)
+
+	pool, _, err := client.Kubernetes.GetNodePool(ctx, clusterID, poolID)
+	if err != nil {
+		return diag.Errorf("Error retrieving Kubernetes node pool: %s", err)
+	}
+
+	nodeIDs, err := nodesToRecycle(pool, d.Get("node_ids").(*schema.Set))
+	if err != nil {
+		return diag.FromErr(err)
+	}
+
+	deleteReq := &goApiAbrha.KubernetesNodeDeleteRequest{
+		Replace:   true,
+		SkipDrain: d.Get("skip_drain").(bool),
+	}
+	batchSize := d.Get("max_unavailable").(int)
+	deadline := time.Now().Add(d.Timeout(schema.TimeoutCreate))
+
+	// Set the ID up front so that nodes replaced before a failure are kept
+	// in state.
+	d.SetId(fmt.Sprintf("%s/recycle/%d", poolID, time.Now().UTC().Unix()))
+
+	recycled := make([]string, 0, len(nodeIDs))
+	for start := 0; start < len(nodeIDs); start += batchSize {
+		end := start + batchSize
+		if end > len(nodeIDs) {
+			end = len(nodeIDs)
+		}
+		batch := nodeIDs[start:end]
+
+		for _, nodeID := range batch {
+			log.Printf("[INFO] Replacing node (%s) of Kubernetes node pool (%s)", nodeID, poolID)
+			resp, err := client.Kubernetes.DeleteNode(ctx, clusterID, poolID, nodeID, deleteReq)
+			if err != nil && (resp == nil || resp.StatusCode != http.StatusNotFound) {
+				return diag.Errorf("Error replacing node (%s) of Kubernetes node pool: %s", nodeID, err)
+			}
+		}
+
+		if err := waitForKubernetesNodesReplaced(ctx, client, clusterID, poolID, batch, time.Until(deadline)); err != nil {
+			return diag.Errorf("Error waiting for nodes of Kubernetes node pool to be replaced: %s", err)
+		}
+
+		recycled = append(recycled, batch...)
+		d.Set("recycled_node_ids", recycled)
+	}
+
+	return resourceAbrhaKubernetesNodePoolRecycleRead(ctx, d, meta)
+}
+
+func resourceAbrhaKubernetesNodePoolRecycleRead(ctx context.Context, d *schema.ResourceData, meta interface{}) diag.Diagnostics {
+	client := meta.(*config.CombinedConfig).GoApiAbrhaClient()
+
+	_, resp, err := client.Kubernetes.GetNodePool(ctx, d.Get("cluster_id").(string), d.Get("node_pool_id").(string))
+	if err != nil {
+		if resp != nil && resp.StatusCode == http.StatusNotFound {
+			log.Printf("[WARN] Kubernetes node pool (%s) not found, removing recycle from state", d.Get("node_pool_id").(string))
+			d.SetId("")
+			return nil
+		}
+
+		return diag.Errorf("Error retrieving Kubernetes node pool: %s", err)
+	}
+
+	return nil
+}
+
+func resourceAbrhaKubernetesNodePoolRecycleDelete(ctx context.Context, d *schema.ResourceData, meta interface{}) diag.Diagnostics {
+	// Replaced nodes cannot be restored, so there is nothing to undo.
+	d.SetId("")
+	return nil
+}
+
+// nodesToRecycle returns the IDs of the pool's nodes to replace, in the order
+// the API lists them. When targets is empty, all nodes are returned.
+func nodesToRecycle(pool *goApiAbrha.KubernetesNodePool, targets *schema.Set) ([]string, error) {
+	inPool := make(map[string]bool, len(pool.Nodes))
+	nodeIDs := make([]string, 0, len(pool.Nodes))
+	for _, node := range pool.Nodes {
+		inPool[node.ID] = true
+		if targets.Len() == 0 || targets.Contains(node.ID) {
+			nodeIDs = append(nodeIDs, node.ID)
+		}
+	}
+
+	for _, id := range targets.List() {
+		if !inPool[id.(string)] {
+			return nil, fmt.Errorf("Node (%s) is not part of Kubernetes node pool (%s)", id.(string), pool.ID)
+		}
+	}
+
+	return nodeIDs, nil
+}
+
+// waitForKubernetesNodesReplaced waits until the given nodes are gone from a
+// node pool and all of its nodes are running. The pool's current size is used
+// rather than its size before the replacement, as autoscaling may change it.
+func waitForKubernetesNodesReplaced(ctx context.Context, client *goApiAbrha.Client, clusterID, poolID string, replaced []string, timeout time.Duration) error {
+	stateConf := &retry.StateChangeConf{
+		Pending: []string{"replacing"},
+		Target:  []string{"running"},
+		Refresh: func() (interface{}, string, error) {
+			pool, _, err := client.Kubernetes.GetNodePool(ctx, clusterID, poolID)
+			if err != nil {
+				return nil, "", fmt.Errorf("Error trying to read nodepool state: %s", err)
+			}
+
+			running := 0
+			for _, node := range pool.Nodes {
+				for _, id := range replaced {
+					if node.ID == id {
+						log.Printf("[DEBUG] Waiting for node (%s) of Kubernetes node pool (%s) to be replaced", id, poolID)
+						return pool, "replacing", nil
+					}
+				}
+
+				if node.Status != nil && node.Status.State == "running" {
+					running++
+				}
+			}
+
+			if running < pool.Count || running != len(pool.Nodes) {
+				log.Printf("[DEBUG] Waiting for replacement nodes of Kubernetes node pool (%s): %d of %d running", poolID, running, pool.Count)
+				return pool, "replacing", nil
+			}
+
+			return pool, "running", nil
+		},
+		Delay:      10 * time.Second,
+		Timeout:    timeout,
+		MinTimeout: 10 * time.Second,
+	}
+
+	_, err := stateConf.WaitForStateContext(ctx)
+	return err
+}
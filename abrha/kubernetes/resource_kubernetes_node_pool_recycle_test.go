@@ -0,0 +1,64 @@
+package kubernetes_test
+
+import (
+	"fmt"
+	"testing"
+
+	goApiAbrha "github.com/abrhacom/go-api-abrha"
+	"github.com/abrhacom/terraform-provider-abrha/abrha/acceptance"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/resource"
+)
+
+func TestAccAbrhaKubernetesNodePoolRecycle_Basic(t *testing.T) {
+	rName := acceptance.RandomTestName()
+	var k8s goApiAbrha.KubernetesCluster
+
+	resource.ParallelTest(t, resource.TestCase{
+		PreCheck:          func() { acceptance.TestAccPreCheck(t) },
+		ProviderFactories: acceptance.TestAccProviderFactories,
+		CheckDestroy:      testAccCheckAbrhaKubernetesClusterDestroy,
+		Steps: []resource.TestStep{
+			{
+				Config: testAccAbrhaKubernetesConfigNodePoolRecycle(rName, "one"),
+				Check: resource.ComposeTestCheckFunc(
+					testAccCheckAbrhaKubernetesClusterExists("abrha_kubernetes_cluster.foobar", &k8s),
+					resource.TestCheckResourceAttr("abrha_kubernetes_node_pool_recycle.foobar", "max_unavailable", "1"),
+					resource.TestCheckResourceAttr("abrha_kubernetes_node_pool_recycle.foobar", "recycled_node_ids.#", "2"),
+				),
+			},
+			{
+				Config: testAccAbrhaKubernetesConfigNodePoolRecycle(rName, "two"),
+				Check: resource.ComposeTestCheckFunc(
+					resource.TestCheckResourceAttr("abrha_kubernetes_node_pool_recycle.foobar", "triggers.image", "two"),
+					resource.TestCheckResourceAttr("abrha_kubernetes_node_pool_recycle.foobar", "recycled_node_ids.#", "2"),
+				),
+			},
+		},
+	})
+}
+
+func testAccAbrhaKubernetesConfigNodePoolRecycle(rName, trigger string) string {
+	return fmt.Sprintf(`%s
+
+resource "abrha_kubernetes_cluster" "foobar" {
+  name    = "%s"
+  region  = "lon1"
+  version = data.abrha_kubernetes_versions.test.latest_version
+
+  node_pool {
+    name       = "default"
+    size       = "s-1vcpu-2gb"
+    node_count = 2
+  }
+}
+
+resource "abrha_kubernetes_node_pool_recycle" "foobar" {
+  cluster_id   = abrha_kubernetes_cluster.foobar.id
+  node_pool_id = abrha_kubernetes_cluster.foobar.node_pool[0].id
+
+  triggers = {
+    image = "%s"
+  }
+}
+`, testClusterVersionLatest, rName, trigger)
+}
@@ -0,0 +1,17 @@
+package loadbalancer
+
+import (
+	"github.com/abrhacom/terraform-provider-abrha/internal/datalist"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/schema"
+)
+
+func DataSourceAbrhaLoadbalancers() *schema.Resource {
+	dataListConfig := &datalist.ResourceConfig{
+		RecordSchema:        loadbalancerSchema(),
+		ResultAttributeName: "load_balancers",
+		GetRecords:          getAbrhaLoadbalancers,
+		FlattenRecord:       flattenAbrhaLoadbalancer,
+	}
+
+	return datalist.NewResource(dataListConfig)
+}
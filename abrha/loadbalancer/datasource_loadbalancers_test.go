@@ -0,0 +1,72 @@
+package loadbalancer_test
+
+import (
+	"fmt"
+	"testing"
+
+	"github.com/abrhacom/terraform-provider-abrha/abrha/acceptance"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/resource"
+)
+
+func TestAccDataSourceAbrhaLoadBalancers_Basic(t *testing.T) {
+	testName1 := acceptance.RandomTestName()
+	testName2 := acceptance.RandomTestName()
+
+	resourcesConfig := fmt.Sprintf(`
+resource "abrha_loadbalancer" "foo" {
+  name   = "%s"
+  region = "nyc3"
+
+  forwarding_rule {
+    entry_port      = 80
+    entry_protocol  = "http"
+    target_port     = 80
+    target_protocol = "http"
+  }
+}
+
+resource "abrha_loadbalancer" "bar" {
+  name   = "%s"
+  region = "nyc3"
+
+  forwarding_rule {
+    entry_port      = 80
+    entry_protocol  = "http"
+    target_port     = 80
+    target_protocol = "http"
+  }
+}
+`, testName1, testName2)
+
+	datasourceConfig := fmt.Sprintf(`
+data "abrha_loadbalancers" "result" {
+  filter {
+    key    = "name"
+    values = ["%s"]
+  }
+}
+`, testName1)
+
+	resource.ParallelTest(t, resource.TestCase{
+		PreCheck:          func() { acceptance.TestAccPreCheck(t) },
+		ProviderFactories: acceptance.TestAccProviderFactories,
+		Steps: []resource.TestStep{
+			{
+				Config: resourcesConfig,
+			},
+			{
+				Config: resourcesConfig + datasourceConfig,
+				Check: resource.ComposeTestCheckFunc(
+					resource.TestCheckResourceAttr("data.abrha_loadbalancers.result", "load_balancers.#", "1"),
+					resource.TestCheckResourceAttr("data.abrha_loadbalancers.result", "load_balancers.0.name", testName1),
+					resource.TestCheckResourceAttr("data.abrha_loadbalancers.result", "load_balancers.0.region", "nyc3"),
+					resource.TestCheckResourceAttrPair("data.abrha_loadbalancers.result", "load_balancers.0.id", "abrha_loadbalancer.foo", "id"),
+					resource.TestCheckResourceAttrSet("data.abrha_loadbalancers.result", "load_balancers.0.urn"),
+				),
+			},
+			{
+				Config: resourcesConfig,
+			},
+		},
+	})
+}
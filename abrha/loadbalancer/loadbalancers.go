@@ -0,0 +1,188 @@
+package loadbalancer
+
+import (
+	"context"
+	"fmt"
+
+	goApiAbrha "github.com/abrhacom/go-api-abrha"
+	"github.com/abrhacom/terraform-provider-abrha/abrha/config"
+	"github.com/abrhacom/terraform-provider-abrha/abrha/tag"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/schema"
+)
+
+func loadbalancerSchema() map[string]*schema.Schema {
+	return map[string]*schema.Schema{
+		"id": {
+			Type:        schema.TypeString,
+			Description: "id of the load balancer",
+		},
+		"name": {
+			Type:        schema.TypeString,
+			Description: "name of the load balancer",
+		},
+		"urn": {
+			Type:        schema.TypeString,
+			Description: "the uniform resource name for the load balancer",
+		},
+		"region": {
+			Type:        schema.TypeString,
+			Description: "the region that the load balancer is deployed in",
+		},
+		"size": {
+			Type:        schema.TypeString,
+			Description: "the size of the load balancer",
+		},
+		"size_unit": {
+			Type:        schema.TypeInt,
+			Description: "the number of nodes of the load balancer",
+		},
+		"type": {
+			Type:        schema.TypeString,
+			Description: "the type of the load balancer",
+		},
+		"network": {
+			Type:        schema.TypeString,
+			Description: "the network visibility of the load balancer",
+		},
+		"ip": {
+			Type:        schema.TypeString,
+			Description: "the public ipv4 address of the load balancer",
+		},
+		"ipv6": {
+			Type:        schema.TypeString,
+			Description: "the public ipv6 address of the load balancer",
+		},
+		"algorithm": {
+			Type:        schema.TypeString,
+			Description: "the load balancing algorithm",
+		},
+		"status": {
+			Type:        schema.TypeString,
+			Description: "the status of the load balancer",
+		},
+		"created_at": {
+			Type:        schema.TypeString,
+			Description: "the creation date of the load balancer",
+		},
+		"vm_ids": {
+			Type:        schema.TypeSet,
+			Elem:        &schema.Schema{Type: schema.TypeString},
+			Description: "ids of the vms assigned to the load balancer",
+		},
+		"vm_tag": {
+			Type:        schema.TypeString,
+			Description: "the tag used to assign vms to the load balancer",
+		},
+		"tags": tag.TagsDataSourceSchema(),
+		"redirect_http_to_https": {
+			Type:        schema.TypeBool,
+			Description: "whether http traffic is redirected to https",
+		},
+		"enable_proxy_protocol": {
+			Type:        schema.TypeBool,
+			Description: "whether PROXY protocol is enabled",
+		},
+		"enable_backend_keepalive": {
+			Type:        schema.TypeBool,
+			Description: "whether http keepalive connections are maintained to target vms",
+		},
+		"http_idle_timeout_seconds": {
+			Type:        schema.TypeInt,
+			Description: "the http idle timeout in seconds",
+		},
+		"disable_lets_encrypt_dns_records": {
+			Type:        schema.TypeBool,
+			Description: "whether automatic DNS record creation for Let's Encrypt certificates is disabled",
+		},
+		"vpc_uuid": {
+			Type:        schema.TypeString,
+			Description: "UUID of the VPC in which the load balancer is located",
+		},
+		"project_id": {
+			Type:        schema.TypeString,
+			Description: "the id of the project that the load balancer is assigned to",
+		},
+	}
+}
+
+func getAbrhaLoadbalancers(meta interface{}, extra map[string]interface{}) ([]interface{}, error) {
+	client := meta.(*config.CombinedConfig).GoApiAbrhaClient()
+
+	opts := &goApiAbrha.ListOptions{
+		Page:    1,
+		PerPage: 200,
+	}
+
+	var lbList []interface{}
+
+	for {
+		lbs, resp, err := client.LoadBalancers.List(context.Background(), opts)
+		if err != nil {
+			return nil, fmt.Errorf("Error retrieving load balancers: %s", err)
+		}
+
+		for _, lb := range lbs {
+			lbList = append(lbList, lb)
+		}
+
+		if resp.Links == nil || resp.Links.IsLastPage() {
+			break
+		}
+
+		page, err := resp.Links.CurrentPage()
+		if err != nil {
+			return nil, fmt.Errorf("Error retrieving load balancers: %s", err)
+		}
+
+		opts.Page = page + 1
+	}
+
+	return lbList, nil
+}
+
+func flattenAbrhaLoadbalancer(rawLoadbalancer, meta interface{}, extra map[string]interface{}) (map[string]interface{}, error) {
+	lb := rawLoadbalancer.(goApiAbrha.LoadBalancer)
+
+	flattenedLoadbalancer := map[string]interface{}{
+		"id":                       lb.ID,
+		"name":                     lb.Name,
+		"urn":                      lb.URN(),
+		"size":                     lb.SizeSlug,
+		"size_unit":                int(lb.SizeUnit),
+		"type":                     lb.Type,
+		"network":                  lb.Network,
+		"ip":                       lb.IP,
+		"ipv6":                     lb.IPv6,
+		"algorithm":                lb.Algorithm,
+		"status":                   lb.Status,
+		"created_at":               lb.Created,
+		"vm_tag":                   lb.Tag,
+		"redirect_http_to_https":   lb.RedirectHttpToHttps,
+		"enable_proxy_protocol":    lb.EnableProxyProtocol,
+		"enable_backend_keepalive": lb.EnableBackendKeepalive,
+		"vpc_uuid":                 lb.VPCUUID,
+		"project_id":               lb.ProjectID,
+	}
+
+	if lb.Region != nil {
+		flattenedLoadbalancer["region"] = lb.Region.Slug
+	}
+
+	if lb.HTTPIdleTimeoutSeconds != nil {
+		flattenedLoadbalancer["http_idle_timeout_seconds"] = int(*lb.HTTPIdleTimeoutSeconds)
+	}
+
+	if lb.DisableLetsEncryptDNSRecords != nil {
+		flattenedLoadbalancer["disable_lets_encrypt_dns_records"] = *lb.DisableLetsEncryptDNSRecords
+	}
+
+	vmIDs := schema.NewSet(schema.HashString, []interface{}{})
+	for _, id := range lb.VmIDs {
+		vmIDs.Add(id)
+	}
+	flattenedLoadbalancer["vm_ids"] = vmIDs
+
+	flattenedLoadbalancer["tags"] = tag.FlattenTags(lb.Tags)
+
+	return flattenedLoadbalancer, nil
+}
@@ -39,6 +39,10 @@ func ResourceAbrhaLoadbalancer() *schema.Resource {
 
 		Schema: resourceAbrhaLoadBalancerV1(),
 
+		Timeouts: &schema.ResourceTimeout{
+			Create: schema.DefaultTimeout(10 * time.Minute),
+		},
+
 		CustomizeDiff: func(ctx context.Context, diff *schema.ResourceDiff, v interface{}) error {
 
 			if _, hasHealthCheck := diff.GetOk("healthcheck"); hasHealthCheck {
@@ -709,7 +713,7 @@ func resourceAbrhaLoadbalancerCreate(ctx context.Context, d *schema.ResourceData
 		Pending:    []string{"new"},
 		Target:     []string{"active"},
 		Refresh:    loadbalancerStateRefreshFunc(client, d.Id()),
-		Timeout:    10 * time.Minute,
+		Timeout:    d.Timeout(schema.TimeoutCreate),
 		MinTimeout: 15 * time.Second,
 	}
 	if _, err := stateConf.WaitForStateContext(ctx); err != nil {
@@ -0,0 +1,26 @@
+package oneclick
+
+import (
+	"github.com/abrhacom/terraform-provider-abrha/internal/datalist"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/schema"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/validation"
+)
+
+func DataSourceAbrhaOneClicks() *schema.Resource {
+	dataListConfig := &datalist.ResourceConfig{
+		RecordSchema:        oneClickSchema(),
+		ResultAttributeName: "one_clicks",
+		ExtraQuerySchema: map[string]*schema.Schema{
+			"type": {
+				Type:         schema.TypeString,
+				Optional:     true,
+				ValidateFunc: validation.NoZeroValues,
+				Description:  "the type of 1-click apps to list, such as `kubernetes`. All apps are listed when unset.",
+			},
+		},
+		GetRecords:    getAbrhaOneClicks,
+		FlattenRecord: flattenAbrhaOneClick,
+	}
+
+	return datalist.NewResource(dataListConfig)
+}
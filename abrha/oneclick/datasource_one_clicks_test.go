@@ -0,0 +1,34 @@
+package oneclick_test
+
+import (
+	"testing"
+
+	"github.com/abrhacom/terraform-provider-abrha/abrha/acceptance"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/resource"
+)
+
+func TestAccDataSourceAbrhaOneClicks_Basic(t *testing.T) {
+	resource.ParallelTest(t, resource.TestCase{
+		PreCheck:          func() { acceptance.TestAccPreCheck(t) },
+		ProviderFactories: acceptance.TestAccProviderFactories,
+		Steps: []resource.TestStep{
+			{
+				Config: testAccCheckDataSourceAbrhaOneClicksConfigKubernetes,
+				Check: resource.ComposeTestCheckFunc(
+					resource.TestCheckResourceAttrSet("data.abrha_one_clicks.foobar", "one_clicks.0.slug"),
+					resource.TestCheckResourceAttr("data.abrha_one_clicks.foobar", "one_clicks.0.type", "kubernetes"),
+				),
+			},
+		},
+	})
+}
+
+const testAccCheckDataSourceAbrhaOneClicksConfigKubernetes = `
+data "abrha_one_clicks" "foobar" {
+  type = "kubernetes"
+
+  sort {
+    key       = "slug"
+    direction = "asc"
+  }
+}`
@@ -0,0 +1,55 @@
+package oneclick
+
+import (
+	"context"
+	"fmt"
+
+	goApiAbrha "github.com/abrhacom/go-api-abrha"
+	"github.com/abrhacom/terraform-provider-abrha/abrha/config"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/schema"
+)
+
+func oneClickSchema() map[string]*schema.Schema {
+	return map[string]*schema.Schema{
+		"slug": {
+			Type:        schema.TypeString,
+			Description: "the slug of the 1-click app, usable as an add-on slug for Kubernetes apps",
+		},
+		"type": {
+			Type:        schema.TypeString,
+			Description: "the type of the 1-click app",
+		},
+	}
+}
+
+func getAbrhaOneClicks(meta interface{}, extra map[string]interface{}) ([]interface{}, error) {
+	client := meta.(*config.CombinedConfig).GoApiAbrhaClient()
+
+	oneClickType, ok := extra["type"].(string)
+	if !ok {
+		return nil, fmt.Errorf("unable to find `type` key from query data")
+	}
+
+	oneClicks, _, err := client.OneClick.List(context.Background(), oneClickType)
+	if err != nil {
+		return nil, fmt.Errorf("Error retrieving 1-click apps: %s", err)
+	}
+
+	var oneClickList []interface{}
+	for _, oneClick := range oneClicks {
+		oneClickList = append(oneClickList, oneClick)
+	}
+
+	return oneClickList, nil
+}
+
+func flattenAbrhaOneClick(rawOneClick, meta interface{}, extra map[string]interface{}) (map[string]interface{}, error) {
+	oneClick := rawOneClick.(*goApiAbrha.OneClick)
+
+	flattenedOneClick := map[string]interface{}{
+		"slug": oneClick.Slug,
+		"type": oneClick.Type,
+	}
+
+	return flattenedOneClick, nil
+}
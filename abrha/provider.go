@@ -5,16 +5,19 @@ import (
 
 	"github.com/abrhacom/terraform-provider-abrha/abrha/account"
 	"github.com/abrhacom/terraform-provider-abrha/abrha/app"
+	"github.com/abrhacom/terraform-provider-abrha/abrha/billing"
 	"github.com/abrhacom/terraform-provider-abrha/abrha/cdn"
 	"github.com/abrhacom/terraform-provider-abrha/abrha/certificate"
 	"github.com/abrhacom/terraform-provider-abrha/abrha/config"
 	"github.com/abrhacom/terraform-provider-abrha/abrha/database"
 	"github.com/abrhacom/terraform-provider-abrha/abrha/domain"
 	"github.com/abrhacom/terraform-provider-abrha/abrha/firewall"
+	"github.com/abrhacom/terraform-provider-abrha/abrha/function"
 	"github.com/abrhacom/terraform-provider-abrha/abrha/image"
 	"github.com/abrhacom/terraform-provider-abrha/abrha/kubernetes"
 	"github.com/abrhacom/terraform-provider-abrha/abrha/loadbalancer"
 	"github.com/abrhacom/terraform-provider-abrha/abrha/monitoring"
+	"github.com/abrhacom/terraform-provider-abrha/abrha/oneclick"
 	"github.com/abrhacom/terraform-provider-abrha/abrha/project"
 	"github.com/abrhacom/terraform-provider-abrha/abrha/region"
 	"github.com/abrhacom/terraform-provider-abrha/abrha/registry"
@@ -99,49 +102,76 @@ func Provider() *schema.Provider {
 			},
 		},
 		DataSourcesMap: map[string]*schema.Resource{
-			"abrha_account":                  account.DataSourceAbrhaAccount(),
-			"abrha_app":                      app.DataSourceAbrhaApp(),
-			"abrha_certificate":              certificate.DataSourceAbrhaCertificate(),
-			"abrha_container_registry":       registry.DataSourceAbrhaContainerRegistry(),
-			"abrha_database_cluster":         database.DataSourceAbrhaDatabaseCluster(),
-			"abrha_database_connection_pool": database.DataSourceAbrhaDatabaseConnectionPool(),
-			"abrha_database_ca":              database.DataSourceAbrhaDatabaseCA(),
-			"abrha_database_replica":         database.DataSourceAbrhaDatabaseReplica(),
-			"abrha_database_user":            database.DataSourceAbrhaDatabaseUser(),
-			"abrha_domain":                   domain.DataSourceAbrhaDomain(),
-			"abrha_domains":                  domain.DataSourceAbrhaDomains(),
-			"abrha_vm":                       vm.DataSourceAbrhaVm(),
-			"abrha_vm_autoscale":             vmautoscale.DataSourceAbrhaVmAutoscale(),
-			"abrha_vms":                      vm.DataSourceAbrhaVms(),
-			"abrha_vm_snapshot":              snapshot.DataSourceAbrhaVmSnapshot(),
-			"abrha_firewall":                 firewall.DataSourceAbrhaFirewall(),
-			"abrha_floating_ip":              reservedip.DataSourceAbrhaFloatingIP(),
-			"abrha_image":                    image.DataSourceAbrhaImage(),
-			"abrha_images":                   image.DataSourceAbrhaImages(),
-			"abrha_kubernetes_cluster":       kubernetes.DataSourceAbrhaKubernetesCluster(),
-			"abrha_kubernetes_versions":      kubernetes.DataSourceAbrhaKubernetesVersions(),
-			"abrha_loadbalancer":             loadbalancer.DataSourceAbrhaLoadbalancer(),
-			"abrha_project":                  project.DataSourceAbrhaProject(),
-			"abrha_projects":                 project.DataSourceAbrhaProjects(),
-			"abrha_record":                   domain.DataSourceAbrhaRecord(),
-			"abrha_records":                  domain.DataSourceAbrhaRecords(),
-			"abrha_region":                   region.DataSourceAbrhaRegion(),
-			"abrha_regions":                  region.DataSourceAbrhaRegions(),
-			"abrha_reserved_ip":              reservedip.DataSourceAbrhaReservedIP(),
-			"abrha_reserved_ipv6":            reservedipv6.DataSourceAbrhaReservedIPV6(),
-			"abrha_sizes":                    size.DataSourceAbrhaSizes(),
-			"abrha_spaces_bucket":            spaces.DataSourceAbrhaSpacesBucket(),
-			"abrha_spaces_buckets":           spaces.DataSourceAbrhaSpacesBuckets(),
-			"abrha_spaces_bucket_object":     spaces.DataSourceAbrhaSpacesBucketObject(),
-			"abrha_spaces_bucket_objects":    spaces.DataSourceAbrhaSpacesBucketObjects(),
-			"abrha_ssh_key":                  sshkey.DataSourceAbrhaSSHKey(),
-			"abrha_ssh_keys":                 sshkey.DataSourceAbrhaSSHKeys(),
-			"abrha_tag":                      tag.DataSourceAbrhaTag(),
-			"abrha_tags":                     tag.DataSourceAbrhaTags(),
-			"abrha_volume_snapshot":          snapshot.DataSourceAbrhaVolumeSnapshot(),
-			"abrha_volume":                   volume.DataSourceAbrhaVolume(),
-			"abrha_vpc":                      vpc.DataSourceAbrhaVPC(),
-			"abrha_vpc_peering":              vpcpeering.DataSourceAbrhaVPCPeering(),
+			"abrha_account":                         account.DataSourceAbrhaAccount(),
+			"abrha_app":                             app.DataSourceAbrhaApp(),
+			"abrha_app_spec_proposal":               app.DataSourceAbrhaAppSpecProposal(),
+			"abrha_apps":                            app.DataSourceAbrhaApps(),
+			"abrha_balance":                         billing.DataSourceAbrhaBalance(),
+			"abrha_billing_history":                 billing.DataSourceAbrhaBillingHistory(),
+			"abrha_cdns":                            cdn.DataSourceAbrhaCDNs(),
+			"abrha_certificate":                     certificate.DataSourceAbrhaCertificate(),
+			"abrha_certificates":                    certificate.DataSourceAbrhaCertificates(),
+			"abrha_container_registry":              registry.DataSourceAbrhaContainerRegistry(),
+			"abrha_container_registry_repositories": registry.DataSourceAbrhaContainerRegistryRepositories(),
+			"abrha_container_registry_repository_manifests": registry.DataSourceAbrhaContainerRegistryRepositoryManifests(),
+			"abrha_container_registry_repository_tags":      registry.DataSourceAbrhaContainerRegistryRepositoryTags(),
+			"abrha_database_cluster":                        database.DataSourceAbrhaDatabaseCluster(),
+			"abrha_database_clusters":                       database.DataSourceAbrhaDatabaseClusters(),
+			"abrha_database_connection_pool":                database.DataSourceAbrhaDatabaseConnectionPool(),
+			"abrha_database_backups":                        database.DataSourceAbrhaDatabaseBackups(),
+			"abrha_database_ca":                             database.DataSourceAbrhaDatabaseCA(),
+			"abrha_database_events":                         database.DataSourceAbrhaDatabaseEvents(),
+			"abrha_database_opensearch_indexes":             database.DataSourceAbrhaDatabaseOpensearchIndexes(),
+			"abrha_database_replica":                        database.DataSourceAbrhaDatabaseReplica(),
+			"abrha_database_user":                           database.DataSourceAbrhaDatabaseUser(),
+			"abrha_domain":                                  domain.DataSourceAbrhaDomain(),
+			"abrha_domains":                                 domain.DataSourceAbrhaDomains(),
+			"abrha_vm":                                      vm.DataSourceAbrhaVm(),
+			"abrha_vm_autoscale":                            vmautoscale.DataSourceAbrhaVmAutoscale(),
+			"abrha_vms":                                     vm.DataSourceAbrhaVms(),
+			"abrha_vm_snapshot":                             snapshot.DataSourceAbrhaVmSnapshot(),
+			"abrha_firewall":                                firewall.DataSourceAbrhaFirewall(),
+			"abrha_firewalls":                               firewall.DataSourceAbrhaFirewalls(),
+			"abrha_floating_ip":                             reservedip.DataSourceAbrhaFloatingIP(),
+			"abrha_function_namespace":                      function.DataSourceAbrhaFunctionNamespace(),
+			"abrha_function_trigger":                        function.DataSourceAbrhaFunctionTrigger(),
+			"abrha_image":                                   image.DataSourceAbrhaImage(),
+			"abrha_images":                                  image.DataSourceAbrhaImages(),
+			"abrha_invoices":                                billing.DataSourceAbrhaInvoices(),
+			"abrha_kubernetes_cluster":                      kubernetes.DataSourceAbrhaKubernetesCluster(),
+			"abrha_kubernetes_cluster_associated_resources": kubernetes.DataSourceAbrhaKubernetesClusterAssociatedResources(),
+			"abrha_kubernetes_cluster_upgrades":             kubernetes.DataSourceAbrhaKubernetesClusterUpgrades(),
+			"abrha_kubernetes_clusterlint":                  kubernetes.DataSourceAbrhaKubernetesClusterlint(),
+			"abrha_kubernetes_clusters":                     kubernetes.DataSourceAbrhaKubernetesClusters(),
+			"abrha_kubernetes_credentials":                  kubernetes.DataSourceAbrhaKubernetesCredentials(),
+			"abrha_kubernetes_versions":                     kubernetes.DataSourceAbrhaKubernetesVersions(),
+			"abrha_loadbalancer":                            loadbalancer.DataSourceAbrhaLoadbalancer(),
+			"abrha_loadbalancers":                           loadbalancer.DataSourceAbrhaLoadbalancers(),
+			"abrha_one_clicks":                              oneclick.DataSourceAbrhaOneClicks(),
+			"abrha_project":                                 project.DataSourceAbrhaProject(),
+			"abrha_projects":                                project.DataSourceAbrhaProjects(),
+			"abrha_record":                                  domain.DataSourceAbrhaRecord(),
+			"abrha_records":                                 domain.DataSourceAbrhaRecords(),
+			"abrha_region":                                  region.DataSourceAbrhaRegion(),
+			"abrha_regions":                                 region.DataSourceAbrhaRegions(),
+			"abrha_reserved_ip":                             reservedip.DataSourceAbrhaReservedIP(),
+			"abrha_reserved_ips":                            reservedip.DataSourceAbrhaReservedIPs(),
+			"abrha_reserved_ipv6":                           reservedipv6.DataSourceAbrhaReservedIPV6(),
+			"abrha_sizes":                                   size.DataSourceAbrhaSizes(),
+			"abrha_spaces_bucket":                           spaces.DataSourceAbrhaSpacesBucket(),
+			"abrha_spaces_buckets":                          spaces.DataSourceAbrhaSpacesBuckets(),
+			"abrha_spaces_bucket_object":                    spaces.DataSourceAbrhaSpacesBucketObject(),
+			"abrha_spaces_bucket_objects":                   spaces.DataSourceAbrhaSpacesBucketObjects(),
+			"abrha_ssh_key":                                 sshkey.DataSourceAbrhaSSHKey(),
+			"abrha_ssh_keys":                                sshkey.DataSourceAbrhaSSHKeys(),
+			"abrha_tag":                                     tag.DataSourceAbrhaTag(),
+			"abrha_tags":                                    tag.DataSourceAbrhaTags(),
+			"abrha_volume_snapshot":                         snapshot.DataSourceAbrhaVolumeSnapshot(),
+			"abrha_volume":                                  volume.DataSourceAbrhaVolume(),
+			"abrha_volumes":                                 volume.DataSourceAbrhaVolumes(),
+			"abrha_vpc":                                     vpc.DataSourceAbrhaVPC(),
+			"abrha_vpcs":                                    vpc.DataSourceAbrhaVPCs(),
+			"abrha_vpc_peering":                             vpcpeering.DataSourceAbrhaVPCPeering(),
 		},
 
 		ResourcesMap: map[string]*schema.Resource{
@@ -149,7 +179,11 @@ func Provider() *schema.Provider {
 			"abrha_certificate":        certificate.ResourceAbrhaCertificate(),
 			"abrha_container_registry": registry.ResourceAbrhaContainerRegistry(),
 			"abrha_container_registry_docker_credentials": registry.ResourceAbrhaContainerRegistryDockerCredentials(),
+			"abrha_container_registry_garbage_collection": registry.ResourceAbrhaContainerRegistryGarbageCollection(),
+			"abrha_database_opensearch_index_retention":   database.ResourceAbrhaDatabaseOpensearchIndexRetention(),
 			"abrha_cdn":                              cdn.ResourceAbrhaCDN(),
+			"abrha_app_deployment":                   app.ResourceAbrhaAppDeployment(),
+			"abrha_app_alert_destination":            app.ResourceAbrhaAppAlertDestination(),
 			"abrha_database_cluster":                 database.ResourceAbrhaDatabaseCluster(),
 			"abrha_database_connection_pool":         database.ResourceAbrhaDatabaseConnectionPool(),
 			"abrha_database_db":                      database.ResourceAbrhaDatabaseDB(),
@@ -163,15 +197,25 @@ func Provider() *schema.Provider {
 			"abrha_database_kafka_config":            database.ResourceAbrhaDatabaseKafkaConfig(),
 			"abrha_database_opensearch_config":       database.ResourceAbrhaDatabaseOpensearchConfig(),
 			"abrha_database_kafka_topic":             database.ResourceAbrhaDatabaseKafkaTopic(),
+			"abrha_database_logsink":                 database.ResourceAbrhaDatabaseLogsink(),
+			"abrha_database_metrics_credentials":     database.ResourceAbrhaDatabaseMetricsCredentials(),
+			"abrha_database_maintenance_update":      database.ResourceAbrhaDatabaseMaintenanceUpdate(),
 			"abrha_domain":                           domain.ResourceAbrhaDomain(),
 			"abrha_vm":                               vm.ResourceAbrhaVm(),
+			"abrha_vm_action":                        vm.ResourceAbrhaVmAction(),
 			"abrha_vm_autoscale":                     vmautoscale.ResourceAbrhaVmAutoscale(),
 			"abrha_vm_snapshot":                      snapshot.ResourceAbrhaVmSnapshot(),
+			"abrha_vm_tag_action":                    vm.ResourceAbrhaVmTagAction(),
 			"abrha_firewall":                         firewall.ResourceAbrhaFirewall(),
 			"abrha_floating_ip":                      reservedip.ResourceAbrhaFloatingIP(),
 			"abrha_floating_ip_assignment":           reservedip.ResourceAbrhaFloatingIPAssignment(),
+			"abrha_function_namespace":               function.ResourceAbrhaFunctionNamespace(),
+			"abrha_function_trigger":                 function.ResourceAbrhaFunctionTrigger(),
+			"abrha_kubernetes_addons":                kubernetes.ResourceAbrhaKubernetesAddons(),
 			"abrha_kubernetes_cluster":               kubernetes.ResourceAbrhaKubernetesCluster(),
+			"abrha_kubernetes_clusterlint":           kubernetes.ResourceAbrhaKubernetesClusterlint(),
 			"abrha_kubernetes_node_pool":             kubernetes.ResourceAbrhaKubernetesNodePool(),
+			"abrha_kubernetes_node_pool_recycle":     kubernetes.ResourceAbrhaKubernetesNodePoolRecycle(),
 			"abrha_loadbalancer":                     loadbalancer.ResourceAbrhaLoadbalancer(),
 			"abrha_monitor_alert":                    monitoring.ResourceAbrhaMonitorAlert(),
 			"abrha_project":                          project.ResourceAbrhaProject(),
@@ -0,0 +1,20 @@
+package registry
+
+import (
+	"github.com/abrhacom/terraform-provider-abrha/internal/datalist"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/schema"
+)
+
+func DataSourceAbrhaContainerRegistryRepositories() *schema.Resource {
+	dataListConfig := &datalist.ResourceConfig{
+		RecordSchema:        repositorySchema(),
+		ResultAttributeName: "repositories",
+		GetRecords:          getAbrhaRepositories,
+		FlattenRecord:       flattenAbrhaRepository,
+		ExtraQuerySchema: map[string]*schema.Schema{
+			"registry_name": registryNameQuerySchema(),
+		},
+	}
+
+	return datalist.NewResource(dataListConfig)
+}
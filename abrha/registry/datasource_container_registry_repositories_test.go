@@ -0,0 +1,41 @@
+package registry_test
+
+import (
+	"fmt"
+	"testing"
+
+	"github.com/abrhacom/terraform-provider-abrha/abrha/acceptance"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/resource"
+)
+
+func TestAccDataSourceAbrhaContainerRegistryRepositories_Basic(t *testing.T) {
+	name := acceptance.RandomTestName()
+	registryConfig := fmt.Sprintf(testAccCheckAbrhaContainerRegistryConfig_basic, name, "starter", "")
+	dataSourceConfig := `
+data "abrha_container_registry_repositories" "foobar" {
+  registry_name = abrha_container_registry.foobar.name
+
+  sort {
+    key       = "updated_at"
+    direction = "desc"
+  }
+}`
+
+	resource.Test(t, resource.TestCase{
+		PreCheck:          func() { acceptance.TestAccPreCheck(t) },
+		ProviderFactories: acceptance.TestAccProviderFactories,
+		CheckDestroy:      testAccCheckAbrhaContainerRegistryDestroy,
+		Steps: []resource.TestStep{
+			{
+				Config: registryConfig,
+			},
+			{
+				Config: registryConfig + dataSourceConfig,
+				Check: resource.ComposeTestCheckFunc(
+					resource.TestCheckResourceAttr(
+						"data.abrha_container_registry_repositories.foobar", "repositories.#", "0"),
+				),
+			},
+		},
+	})
+}
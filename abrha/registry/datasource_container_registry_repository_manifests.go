@@ -0,0 +1,21 @@
+package registry
+
+import (
+	"github.com/abrhacom/terraform-provider-abrha/internal/datalist"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/schema"
+)
+
+func DataSourceAbrhaContainerRegistryRepositoryManifests() *schema.Resource {
+	dataListConfig := &datalist.ResourceConfig{
+		RecordSchema:        repositoryManifestSchema(),
+		ResultAttributeName: "manifests",
+		GetRecords:          getAbrhaRepositoryManifests,
+		FlattenRecord:       flattenAbrhaRepositoryManifest,
+		ExtraQuerySchema: map[string]*schema.Schema{
+			"registry_name":   registryNameQuerySchema(),
+			"repository_name": repositoryNameQuerySchema(),
+		},
+	}
+
+	return datalist.NewResource(dataListConfig)
+}
@@ -0,0 +1,35 @@
+package registry_test
+
+import (
+	"fmt"
+	"regexp"
+	"testing"
+
+	"github.com/abrhacom/terraform-provider-abrha/abrha/acceptance"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/resource"
+)
+
+func TestAccDataSourceAbrhaContainerRegistryRepositoryManifests_NotFound(t *testing.T) {
+	name := acceptance.RandomTestName()
+	registryConfig := fmt.Sprintf(testAccCheckAbrhaContainerRegistryConfig_basic, name, "starter", "")
+	dataSourceConfig := `
+data "abrha_container_registry_repository_manifests" "foobar" {
+  registry_name   = abrha_container_registry.foobar.name
+  repository_name = "does-not-exist"
+}`
+
+	resource.Test(t, resource.TestCase{
+		PreCheck:          func() { acceptance.TestAccPreCheck(t) },
+		ProviderFactories: acceptance.TestAccProviderFactories,
+		CheckDestroy:      testAccCheckAbrhaContainerRegistryDestroy,
+		Steps: []resource.TestStep{
+			{
+				Config: registryConfig,
+			},
+			{
+				Config:      registryConfig + dataSourceConfig,
+				ExpectError: regexp.MustCompile("Error retrieving container registry repository manifests"),
+			},
+		},
+	})
+}
@@ -0,0 +1,281 @@
+package registry
+
+import (
+	"context"
+	"fmt"
+	"time"
+
+	goApiAbrha "github.com/abrhacom/go-api-abrha"
+	"github.com/abrhacom/terraform-provider-abrha/abrha/config"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/schema"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/validation"
+)
+
+func registryNameQuerySchema() *schema.Schema {
+	return &schema.Schema{
+		Type:         schema.TypeString,
+		Required:     true,
+		Description:  "name of the container registry",
+		ValidateFunc: validation.NoZeroValues,
+	}
+}
+
+func repositoryNameQuerySchema() *schema.Schema {
+	return &schema.Schema{
+		Type:         schema.TypeString,
+		Required:     true,
+		Description:  "name of the repository within the container registry",
+		ValidateFunc: validation.NoZeroValues,
+	}
+}
+
+func repositorySchema() map[string]*schema.Schema {
+	return map[string]*schema.Schema{
+		"name": {
+			Type:        schema.TypeString,
+			Description: "name of the repository",
+		},
+		"tag_count": {
+			Type:        schema.TypeInt,
+			Description: "number of tags in the repository",
+		},
+		"manifest_count": {
+			Type:        schema.TypeInt,
+			Description: "number of manifests in the repository",
+		},
+		"latest_manifest_digest": {
+			Type:        schema.TypeString,
+			Description: "digest of the most recently updated manifest",
+		},
+		"latest_manifest_tags": {
+			Type:        schema.TypeList,
+			Elem:        &schema.Schema{Type: schema.TypeString},
+			Description: "tags of the most recently updated manifest",
+		},
+		"updated_at": {
+			Type:        schema.TypeString,
+			Description: "time the most recently updated manifest was pushed",
+		},
+	}
+}
+
+func repositoryTagSchema() map[string]*schema.Schema {
+	return map[string]*schema.Schema{
+		"tag": {
+			Type:        schema.TypeString,
+			Description: "name of the tag",
+		},
+		"manifest_digest": {
+			Type:        schema.TypeString,
+			Description: "digest of the manifest the tag points to",
+		},
+		"compressed_size_bytes": {
+			Type:        schema.TypeInt,
+			Description: "compressed size of the tagged image, in bytes",
+		},
+		"size_bytes": {
+			Type:        schema.TypeInt,
+			Description: "uncompressed size of the tagged image, in bytes",
+		},
+		"updated_at": {
+			Type:        schema.TypeString,
+			Description: "time the tag was last updated",
+		},
+	}
+}
+
+func repositoryManifestSchema() map[string]*schema.Schema {
+	return map[string]*schema.Schema{
+		"digest": {
+			Type:        schema.TypeString,
+			Description: "digest of the manifest",
+		},
+		"tags": {
+			Type:        schema.TypeList,
+			Elem:        &schema.Schema{Type: schema.TypeString},
+			Description: "tags pointing to the manifest",
+		},
+		"compressed_size_bytes": {
+			Type:        schema.TypeInt,
+			Description: "compressed size of the manifest, in bytes",
+		},
+		"size_bytes": {
+			Type:        schema.TypeInt,
+			Description: "uncompressed size of the manifest, in bytes",
+		},
+		"updated_at": {
+			Type:        schema.TypeString,
+			Description: "time the manifest was last updated",
+		},
+	}
+}
+
+func getAbrhaRepositories(meta interface{}, extra map[string]interface{}) ([]interface{}, error) {
+	client := meta.(*config.CombinedConfig).GoApiAbrhaClient()
+	registryName := extra["registry_name"].(string)
+
+	opts := &goApiAbrha.TokenListOptions{
+		PerPage: 200,
+	}
+
+	var repositoryList []interface{}
+
+	for {
+		repositories, resp, err := client.Registry.ListRepositoriesV2(context.Background(), registryName, opts)
+
+		if err != nil {
+			return nil, fmt.Errorf("Error retrieving container registry repositories: %s", err)
+		}
+
+		for _, repository := range repositories {
+			repositoryList = append(repositoryList, repository)
+		}
+
+		if resp.Links == nil || resp.Links.IsLastPage() {
+			break
+		}
+
+		token, err := resp.Links.NextPageToken()
+		if err != nil {
+			return nil, fmt.Errorf("Error retrieving container registry repositories: %s", err)
+		}
+
+		opts.Token = token
+	}
+
+	return repositoryList, nil
+}
+
+func flattenAbrhaRepository(rawRepository, meta interface{}, extra map[string]interface{}) (map[string]interface{}, error) {
+	repository := rawRepository.(*goApiAbrha.RepositoryV2)
+
+	flattenedRepository := map[string]interface{}{
+		"name":                   repository.Name,
+		"tag_count":              int(repository.TagCount),
+		"manifest_count":         int(repository.ManifestCount),
+		"latest_manifest_digest": "",
+		"latest_manifest_tags":   []interface{}{},
+		"updated_at":             "",
+	}
+
+	if m := repository.LatestManifest; m != nil {
+		flattenedRepository["latest_manifest_digest"] = m.Digest
+		flattenedRepository["latest_manifest_tags"] = flattenStrings(m.Tags)
+		flattenedRepository["updated_at"] = m.UpdatedAt.UTC().Format(time.RFC3339)
+	}
+
+	return flattenedRepository, nil
+}
+
+func getAbrhaRepositoryTags(meta interface{}, extra map[string]interface{}) ([]interface{}, error) {
+	client := meta.(*config.CombinedConfig).GoApiAbrhaClient()
+	registryName := extra["registry_name"].(string)
+	repositoryName := extra["repository_name"].(string)
+
+	opts := &goApiAbrha.ListOptions{
+		Page:    1,
+		PerPage: 200,
+	}
+
+	var tagList []interface{}
+
+	for {
+		tags, resp, err := client.Registry.ListRepositoryTags(context.Background(), registryName, repositoryName, opts)
+
+		if err != nil {
+			return nil, fmt.Errorf("Error retrieving container registry repository tags: %s", err)
+		}
+
+		for _, tag := range tags {
+			tagList = append(tagList, tag)
+		}
+
+		if resp.Links == nil || resp.Links.IsLastPage() {
+			break
+		}
+
+		page, err := resp.Links.CurrentPage()
+		if err != nil {
+			return nil, fmt.Errorf("Error retrieving container registry repository tags: %s", err)
+		}
+
+		opts.Page = page + 1
+	}
+
+	return tagList, nil
+}
+
+func flattenAbrhaRepositoryTag(rawTag, meta interface{}, extra map[string]interface{}) (map[string]interface{}, error) {
+	tag := rawTag.(*goApiAbrha.RepositoryTag)
+
+	flattenedTag := map[string]interface{}{
+		"tag":                   tag.Tag,
+		"manifest_digest":       tag.ManifestDigest,
+		"compressed_size_bytes": int(tag.CompressedSizeBytes),
+		"size_bytes":            int(tag.SizeBytes),
+		"updated_at":            tag.UpdatedAt.UTC().Format(time.RFC3339),
+	}
+
+	return flattenedTag, nil
+}
+
+func getAbrhaRepositoryManifests(meta interface{}, extra map[string]interface{}) ([]interface{}, error) {
+	client := meta.(*config.CombinedConfig).GoApiAbrhaClient()
+	registryName := extra["registry_name"].(string)
+	repositoryName := extra["repository_name"].(string)
+
+	opts := &goApiAbrha.ListOptions{
+		Page:    1,
+		PerPage: 200,
+	}
+
+	var manifestList []interface{}
+
+	for {
+		manifests, resp, err := client.Registry.ListRepositoryManifests(context.Background(), registryName, repositoryName, opts)
+
+		if err != nil {
+			return nil, fmt.Errorf("Error retrieving container registry repository manifests: %s", err)
+		}
+
+		for _, manifest := range manifests {
+			manifestList = append(manifestList, manifest)
+		}
+
+		if resp.Links == nil || resp.Links.IsLastPage() {
+			break
+		}
+
+		page, err := resp.Links.CurrentPage()
+		if err != nil {
+			return nil, fmt.Errorf("Error retrieving container registry repository manifests: %s", err)
+		}
+
+		opts.Page = page + 1
+	}
+
+	return manifestList, nil
+}
+
+func flattenAbrhaRepositoryManifest(rawManifest, meta interface{}, extra map[string]interface{}) (map[string]interface{}, error) {
+	manifest := rawManifest.(*goApiAbrha.RepositoryManifest)
+
+	flattenedManifest := map[string]interface{}{
+		"digest":                manifest.Digest,
+		"tags":                  flattenStrings(manifest.Tags),
+		"compressed_size_bytes": int(manifest.CompressedSizeBytes),
+		"size_bytes":            int(manifest.SizeBytes),
+		"updated_at":            manifest.UpdatedAt.UTC().Format(time.RFC3339),
+	}
+
+	return flattenedManifest, nil
+}
+
+func flattenStrings(values []string) []interface{} {
+	result := make([]interface{}, 0, len(values))
+	for _, v := range values {
+		result = append(result, v)
+	}
+
+	return result
+}
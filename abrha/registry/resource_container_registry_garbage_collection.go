@@ -0,0 +1,244 @@
+package registry
+
+import (
+	"context"
+	"fmt"
+	"log"
+	"time"
+
+	goApiAbrha "github.com/abrhacom/go-api-abrha"
+	"github.com/abrhacom/terraform-provider-abrha/abrha/config"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/diag"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/retry"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/schema"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/validation"
+)
+
+const (
+	gcStatusSucceeded = "succeeded"
+	gcStatusFailed    = "failed"
+	gcStatusCancelled = "cancelled"
+)
+
+func ResourceAbrhaContainerRegistryGarbageCollection() *schema.Resource {
+	return &schema.Resource{
+		CreateContext: resourceAbrhaContainerRegistryGarbageCollectionCreate,
+		ReadContext:   resourceAbrhaContainerRegistryGarbageCollectionRead,
+		DeleteContext: resourceAbrhaContainerRegistryGarbageCollectionDelete,
+
+		Schema: map[string]*schema.Schema{
+			"registry_name": {
+				Type:         schema.TypeString,
+				Required:     true,
+				ForceNew:     true,
+				ValidateFunc: validation.NoZeroValues,
+			},
+			"type": {
+				Type:     schema.TypeString,
+				Optional: true,
+				ForceNew: true,
+				Default:  string(goApiAbrha.GCTypeUntaggedManifestsAndUnreferencedBlobs),
+				ValidateFunc: validation.StringInSlice([]string{
+					string(goApiAbrha.GCTypeUntaggedManifestsOnly),
+					string(goApiAbrha.GCTypeUnreferencedBlobsOnly),
+					string(goApiAbrha.GCTypeUntaggedManifestsAndUnreferencedBlobs),
+				}, false),
+			},
+			"triggers": {
+				Type:        schema.TypeMap,
+				Optional:    true,
+				ForceNew:    true,
+				Elem:        &schema.Schema{Type: schema.TypeString},
+				Description: "Arbitrary map of values that, when changed, will start a new garbage collection",
+			},
+
+			// Computed attributes
+			"uuid": {
+				Type:     schema.TypeString,
+				Computed: true,
+			},
+			"status": {
+				Type:     schema.TypeString,
+				Computed: true,
+			},
+			"blobs_deleted": {
+				Type:     schema.TypeInt,
+				Computed: true,
+			},
+			"freed_bytes": {
+				Type:     schema.TypeInt,
+				Computed: true,
+			},
+			"created_at": {
+				Type:     schema.TypeString,
+				Computed: true,
+			},
+			"updated_at": {
+				Type:     schema.TypeString,
+				Computed: true,
+			},
+		},
+
+		Timeouts: &schema.ResourceTimeout{
+			Create: schema.DefaultTimeout(60 * time.Minute),
+		},
+	}
+}
+
+func resourceAbrhaContainerRegistryGarbageCollectionCreate(ctx context.Context, d *schema.ResourceData, meta interface{}) diag.Diagnostics {
+	client := meta.(*config.CombinedConfig).GoApiAbrhaClient()
+	registryName := d.Get("registry_name").(string)
+
+	opts := &goApiAbrha.StartGarbageCollectionRequest{
+		Type: goApiAbrha.GarbageCollectionType(d.Get("type").(string)),
+	}
+
+	log.Printf("[DEBUG] Container registry garbage collection create configuration: %#v", opts)
+	gc, _, err := client.Registry.StartGarbageCollection(context.Background(), registryName, opts)
+	if err != nil {
+		return diag.Errorf("Error starting container registry garbage collection: %s", err)
+	}
+
+	d.SetId(gc.UUID)
+	log.Printf("[INFO] Container registry garbage collection: %s", gc.UUID)
+
+	gc, err = waitForGarbageCollection(ctx, client, registryName, gc.UUID, d.Timeout(schema.TimeoutCreate))
+	if err != nil {
+		return diag.FromErr(err)
+	}
+
+	setGarbageCollectionAttributes(d, gc)
+	if gc.Status != gcStatusSucceeded {
+		return diag.Errorf("Container registry garbage collection %s finished with status %q", gc.UUID, gc.Status)
+	}
+
+	return nil
+}
+
+func resourceAbrhaContainerRegistryGarbageCollectionRead(ctx context.Context, d *schema.ResourceData, meta interface{}) diag.Diagnostics {
+	client := meta.(*config.CombinedConfig).GoApiAbrhaClient()
+	registryName := d.Get("registry_name").(string)
+
+	gc, resp, err := findGarbageCollection(client, registryName, d.Id())
+	if err != nil {
+		// If the registry is somehow already destroyed, mark as
+		// successfully gone
+		if resp != nil && resp.StatusCode == 404 {
+			d.SetId("")
+			return nil
+		}
+
+		return diag.Errorf("Error retrieving container registry garbage collection: %s", err)
+	}
+
+	if gc == nil {
+		// Only a limited history of garbage collections is retained, so a
+		// completed run falling out of the list is expected. Keep the last
+		// known state rather than starting a new run.
+		log.Printf("[DEBUG] Container registry garbage collection (%s) is no longer listed; keeping last known state", d.Id())
+		return nil
+	}
+
+	setGarbageCollectionAttributes(d, gc)
+
+	return nil
+}
+
+func resourceAbrhaContainerRegistryGarbageCollectionDelete(ctx context.Context, d *schema.ResourceData, meta interface{}) diag.Diagnostics {
+	client := meta.(*config.CombinedConfig).GoApiAbrhaClient()
+	registryName := d.Get("registry_name").(string)
+
+	// Completed garbage collections cannot be removed; only cancel one that is
+	// still running.
+	if !isGarbageCollectionDone(d.Get("status").(string)) {
+		log.Printf("[INFO] Cancelling container registry garbage collection: %s", d.Id())
+		_, resp, err := client.Registry.UpdateGarbageCollection(context.Background(), registryName, d.Id(), &goApiAbrha.UpdateGarbageCollectionRequest{Cancel: true})
+		if err != nil && (resp == nil || resp.StatusCode != 404) {
+			return diag.Errorf("Error cancelling container registry garbage collection: %s", err)
+		}
+	}
+
+	d.SetId("")
+	return nil
+}
+
+func setGarbageCollectionAttributes(d *schema.ResourceData, gc *goApiAbrha.GarbageCollection) {
+	d.Set("registry_name", gc.RegistryName)
+	d.Set("uuid", gc.UUID)
+	d.Set("status", gc.Status)
+	d.Set("type", string(gc.Type))
+	d.Set("blobs_deleted", int(gc.BlobsDeleted))
+	d.Set("freed_bytes", int(gc.FreedBytes))
+	d.Set("created_at", gc.CreatedAt.UTC().String())
+	d.Set("updated_at", gc.UpdatedAt.UTC().String())
+}
+
+func isGarbageCollectionDone(status string) bool {
+	return status == gcStatusSucceeded || status == gcStatusFailed || status == gcStatusCancelled
+}
+
+// findGarbageCollection looks up a garbage collection by UUID. A nil result
+// without an error means the registry exists but the run is no longer listed.
+func findGarbageCollection(client *goApiAbrha.Client, registryName string, uuid string) (*goApiAbrha.GarbageCollection, *goApiAbrha.Response, error) {
+	opts := &goApiAbrha.ListOptions{
+		Page:    1,
+		PerPage: 200,
+	}
+
+	for {
+		gcs, resp, err := client.Registry.ListGarbageCollections(context.Background(), registryName, opts)
+		if err != nil {
+			return nil, resp, err
+		}
+
+		for _, gc := range gcs {
+			if gc.UUID == uuid {
+				return gc, resp, nil
+			}
+		}
+
+		if resp.Links == nil || resp.Links.IsLastPage() {
+			return nil, resp, nil
+		}
+
+		page, err := resp.Links.CurrentPage()
+		if err != nil {
+			return nil, resp, err
+		}
+
+		opts.Page = page + 1
+	}
+}
+
+func waitForGarbageCollection(ctx context.Context, client *goApiAbrha.Client, registryName string, uuid string, timeout time.Duration) (*goApiAbrha.GarbageCollection, error) {
+	log.Printf("[INFO] Waiting for container registry garbage collection (%s) to complete", uuid)
+	stateConf := &retry.StateChangeConf{
+		Pending: []string{"pending"},
+		Target:  []string{"done"},
+		Refresh: func() (interface{}, string, error) {
+			gc, _, err := findGarbageCollection(client, registryName, uuid)
+			if err != nil {
+				return nil, "", err
+			}
+			if gc == nil {
+				return nil, "", fmt.Errorf("garbage collection %s not found", uuid)
+			}
+
+			if isGarbageCollectionDone(gc.Status) {
+				return gc, "done", nil
+			}
+
+			return gc, "pending", nil
+		},
+		Timeout:    timeout,
+		Delay:      10 * time.Second,
+		MinTimeout: 10 * time.Second,
+	}
+
+	result, err := stateConf.WaitForStateContext(ctx)
+	if err != nil {
+		return nil, fmt.Errorf("Error waiting for container registry garbage collection (%s) to complete: %s", uuid, err)
+	}
+
+	return result.(*goApiAbrha.GarbageCollection), nil
+}
@@ -0,0 +1,56 @@
+package registry_test
+
+import (
+	"fmt"
+	"testing"
+
+	"github.com/abrhacom/terraform-provider-abrha/abrha/acceptance"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/resource"
+)
+
+func TestAccAbrhaContainerRegistryGarbageCollection_Basic(t *testing.T) {
+	name := acceptance.RandomTestName()
+	registryConfig := fmt.Sprintf(testAccCheckAbrhaContainerRegistryConfig_basic, name, "basic", "")
+
+	resource.Test(t, resource.TestCase{
+		PreCheck:          func() { acceptance.TestAccPreCheck(t) },
+		ProviderFactories: acceptance.TestAccProviderFactories,
+		CheckDestroy:      testAccCheckAbrhaContainerRegistryDestroy,
+		Steps: []resource.TestStep{
+			{
+				Config: registryConfig + fmt.Sprintf(testAccCheckAbrhaContainerRegistryGarbageCollectionConfig_basic, "1"),
+				Check: resource.ComposeTestCheckFunc(
+					resource.TestCheckResourceAttrPair(
+						"abrha_container_registry_garbage_collection.foobar", "registry_name", "abrha_container_registry.foobar", "name"),
+					resource.TestCheckResourceAttr(
+						"abrha_container_registry_garbage_collection.foobar", "status", "succeeded"),
+					resource.TestCheckResourceAttr(
+						"abrha_container_registry_garbage_collection.foobar", "type", "untagged manifests and unreferenced blobs"),
+					resource.TestCheckResourceAttrSet(
+						"abrha_container_registry_garbage_collection.foobar", "uuid"),
+					resource.TestCheckResourceAttrSet(
+						"abrha_container_registry_garbage_collection.foobar", "freed_bytes"),
+				),
+			},
+			{
+				// Changing the triggers starts a new garbage collection.
+				Config: registryConfig + fmt.Sprintf(testAccCheckAbrhaContainerRegistryGarbageCollectionConfig_basic, "2"),
+				Check: resource.ComposeTestCheckFunc(
+					resource.TestCheckResourceAttr(
+						"abrha_container_registry_garbage_collection.foobar", "status", "succeeded"),
+					resource.TestCheckResourceAttr(
+						"abrha_container_registry_garbage_collection.foobar", "triggers.run", "2"),
+				),
+			},
+		},
+	})
+}
+
+const testAccCheckAbrhaContainerRegistryGarbageCollectionConfig_basic = `
+resource "abrha_container_registry_garbage_collection" "foobar" {
+  registry_name = abrha_container_registry.foobar.name
+
+  triggers = {
+    run = "%s"
+  }
+}`
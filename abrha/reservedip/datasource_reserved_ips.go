@@ -0,0 +1,17 @@
+package reservedip
+
+import (
+	"github.com/abrhacom/terraform-provider-abrha/internal/datalist"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/schema"
+)
+
+func DataSourceAbrhaReservedIPs() *schema.Resource {
+	dataListConfig := &datalist.ResourceConfig{
+		RecordSchema:        reservedIPSchema(),
+		ResultAttributeName: "reserved_ips",
+		GetRecords:          getAbrhaReservedIPs,
+		FlattenRecord:       flattenAbrhaReservedIP,
+	}
+
+	return datalist.NewResource(dataListConfig)
+}
@@ -0,0 +1,46 @@
+package reservedip_test
+
+import (
+	"testing"
+
+	"github.com/abrhacom/terraform-provider-abrha/abrha/acceptance"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/resource"
+)
+
+func TestAccDataSourceAbrhaReservedIPs_Basic(t *testing.T) {
+	resourceConfig := `
+resource "abrha_reserved_ip" "foo" {
+  region = "nyc3"
+}
+`
+	datasourceConfig := `
+data "abrha_reserved_ips" "result" {
+  filter {
+    key    = "ip_address"
+    values = [abrha_reserved_ip.foo.ip_address]
+  }
+}
+`
+
+	resource.ParallelTest(t, resource.TestCase{
+		PreCheck:          func() { acceptance.TestAccPreCheck(t) },
+		ProviderFactories: acceptance.TestAccProviderFactories,
+		Steps: []resource.TestStep{
+			{
+				Config: resourceConfig,
+			},
+			{
+				Config: resourceConfig + datasourceConfig,
+				Check: resource.ComposeTestCheckFunc(
+					resource.TestCheckResourceAttr("data.abrha_reserved_ips.result", "reserved_ips.#", "1"),
+					resource.TestCheckResourceAttr("data.abrha_reserved_ips.result", "reserved_ips.0.region", "nyc3"),
+					resource.TestCheckResourceAttrPair("data.abrha_reserved_ips.result", "reserved_ips.0.ip_address", "abrha_reserved_ip.foo", "ip_address"),
+					resource.TestCheckResourceAttrPair("data.abrha_reserved_ips.result", "reserved_ips.0.urn", "abrha_reserved_ip.foo", "urn"),
+				),
+			},
+			{
+				Config: resourceConfig,
+			},
+		},
+	})
+}
@@ -0,0 +1,95 @@
+package reservedip
+
+import (
+	"context"
+	"fmt"
+
+	goApiAbrha "github.com/abrhacom/go-api-abrha"
+	"github.com/abrhacom/terraform-provider-abrha/abrha/config"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/schema"
+)
+
+func reservedIPSchema() map[string]*schema.Schema {
+	return map[string]*schema.Schema{
+		"ip_address": {
+			Type:        schema.TypeString,
+			Description: "reserved ip address",
+		},
+		"urn": {
+			Type:        schema.TypeString,
+			Description: "the uniform resource name for the reserved ip",
+		},
+		"region": {
+			Type:        schema.TypeString,
+			Description: "the region that the reserved ip is reserved to",
+		},
+		"vm_id": {
+			Type:        schema.TypeString,
+			Description: "the vm id that the reserved ip has been assigned to",
+		},
+		"project_id": {
+			Type:        schema.TypeString,
+			Description: "the id of the project that the reserved ip is assigned to",
+		},
+		"locked": {
+			Type:        schema.TypeBool,
+			Description: "whether the reserved ip is locked",
+		},
+	}
+}
+
+func getAbrhaReservedIPs(meta interface{}, extra map[string]interface{}) ([]interface{}, error) {
+	client := meta.(*config.CombinedConfig).GoApiAbrhaClient()
+
+	opts := &goApiAbrha.ListOptions{
+		Page:    1,
+		PerPage: 200,
+	}
+
+	var ipList []interface{}
+
+	for {
+		ips, resp, err := client.ReservedIPs.List(context.Background(), opts)
+		if err != nil {
+			return nil, fmt.Errorf("Error retrieving reserved IPs: %s", err)
+		}
+
+		for _, ip := range ips {
+			ipList = append(ipList, ip)
+		}
+
+		if resp.Links == nil || resp.Links.IsLastPage() {
+			break
+		}
+
+		page, err := resp.Links.CurrentPage()
+		if err != nil {
+			return nil, fmt.Errorf("Error retrieving reserved IPs: %s", err)
+		}
+
+		opts.Page = page + 1
+	}
+
+	return ipList, nil
+}
+
+func flattenAbrhaReservedIP(rawReservedIP, meta interface{}, extra map[string]interface{}) (map[string]interface{}, error) {
+	reservedIP := rawReservedIP.(goApiAbrha.ReservedIP)
+
+	flattenedReservedIP := map[string]interface{}{
+		"ip_address": reservedIP.IP,
+		"urn":        reservedIP.URN(),
+		"project_id": reservedIP.ProjectID,
+		"locked":     reservedIP.Locked,
+	}
+
+	if reservedIP.Region != nil {
+		flattenedReservedIP["region"] = reservedIP.Region.Slug
+	}
+
+	if reservedIP.Vm != nil {
+		flattenedReservedIP["vm_id"] = reservedIP.Vm.ID
+	}
+
+	return flattenedReservedIP, nil
+}
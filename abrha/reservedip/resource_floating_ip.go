@@ -3,6 +3,7 @@ package reservedip
 import (
 	"context"
 	"strings"
+	"time"
 
 	goApiAbrha "github.com/abrhacom/go-api-abrha"
 	"github.com/hashicorp/terraform-plugin-sdk/v2/diag"
@@ -49,6 +50,12 @@ func ResourceAbrhaFloatingIP() *schema.Resource {
 				Optional: true,
 			},
 		},
+
+		Timeouts: &schema.ResourceTimeout{
+			Create: schema.DefaultTimeout(60 * time.Minute),
+			Update: schema.DefaultTimeout(60 * time.Minute),
+			Delete: schema.DefaultTimeout(60 * time.Minute),
+		},
 	}
 }
 
@@ -51,6 +51,12 @@ func ResourceAbrhaReservedIP() *schema.Resource {
 				Optional: true,
 			},
 		},
+
+		Timeouts: &schema.ResourceTimeout{
+			Create: schema.DefaultTimeout(60 * time.Minute),
+			Update: schema.DefaultTimeout(60 * time.Minute),
+			Delete: schema.DefaultTimeout(60 * time.Minute),
+		},
 	}
 }
 
@@ -78,7 +84,7 @@ func resourceAbrhaReservedIPCreate(ctx context.Context, d *schema.ResourceData,
 				"Error Assigning reserved IP (%s) to the Vm: %s", d.Id(), err)
 		}
 
-		_, unassignedErr := waitForReservedIPReady(ctx, d, "completed", []string{"new", "in-progress"}, "status", meta, action.ID)
+		_, unassignedErr := waitForReservedIPReady(ctx, d, "completed", []string{"new", "in-progress"}, "status", meta, action.ID, d.Timeout(schema.TimeoutCreate))
 		if unassignedErr != nil {
 			return diag.Errorf(
 				"Error waiting for reserved IP (%s) to be assigned: %s", d.Id(), unassignedErr)
@@ -100,7 +106,7 @@ func resourceAbrhaReservedIPUpdate(ctx context.Context, d *schema.ResourceData,
 					"Error assigning reserved IP (%s) to the Vm: %s", d.Id(), err)
 			}
 
-			_, unassignedErr := waitForReservedIPReady(ctx, d, "completed", []string{"new", "in-progress"}, "status", meta, action.ID)
+			_, unassignedErr := waitForReservedIPReady(ctx, d, "completed", []string{"new", "in-progress"}, "status", meta, action.ID, d.Timeout(schema.TimeoutUpdate))
 			if unassignedErr != nil {
 				return diag.Errorf(
 					"Error waiting for reserved IP (%s) to be Assigned: %s", d.Id(), unassignedErr)
@@ -113,7 +119,7 @@ func resourceAbrhaReservedIPUpdate(ctx context.Context, d *schema.ResourceData,
 					"Error unassigning reserved IP (%s): %s", d.Id(), err)
 			}
 
-			_, unassignedErr := waitForReservedIPReady(ctx, d, "completed", []string{"new", "in-progress"}, "status", meta, action.ID)
+			_, unassignedErr := waitForReservedIPReady(ctx, d, "completed", []string{"new", "in-progress"}, "status", meta, action.ID, d.Timeout(schema.TimeoutUpdate))
 			if unassignedErr != nil {
 				return diag.Errorf(
 					"Error waiting for reserved IP (%s) to be Unassigned: %s", d.Id(), unassignedErr)
@@ -164,7 +170,7 @@ func resourceAbrhaReservedIPDelete(ctx context.Context, d *schema.ResourceData,
 					"Error unassigning reserved IP (%s) from the vm: %s", d.Id(), err)
 			}
 
-			_, unassignedErr := waitForReservedIPReady(ctx, d, "completed", []string{"new", "in-progress"}, "status", meta, action.ID)
+			_, unassignedErr := waitForReservedIPReady(ctx, d, "completed", []string{"new", "in-progress"}, "status", meta, action.ID, d.Timeout(schema.TimeoutDelete))
 			if unassignedErr != nil {
 				return diag.Errorf(
 					"Error waiting for reserved IP (%s) to be unassigned: %s", d.Id(), unassignedErr)
@@ -205,7 +211,7 @@ func resourceAbrhaReservedIPImport(ctx context.Context, d *schema.ResourceData,
 }
 
 func waitForReservedIPReady(
-	ctx context.Context, d *schema.ResourceData, target string, pending []string, attribute string, meta interface{}, actionID int) (interface{}, error) {
+	ctx context.Context, d *schema.ResourceData, target string, pending []string, attribute string, meta interface{}, actionID int, timeout time.Duration) (interface{}, error) {
 	log.Printf(
 		"[INFO] Waiting for reserved IP (%s) to have %s of %s",
 		d.Id(), attribute, target)
@@ -214,7 +220,7 @@ func waitForReservedIPReady(
 		Pending:    pending,
 		Target:     []string{target},
 		Refresh:    newReservedIPStateRefreshFunc(d, attribute, meta, actionID),
-		Timeout:    60 * time.Minute,
+		Timeout:    timeout,
 		Delay:      10 * time.Second,
 		MinTimeout: 3 * time.Second,
 
@@ -40,6 +40,11 @@ func ResourceAbrhaReservedIPAssignment() *schema.Resource {
 				ValidateFunc: validation.NoZeroValues,
 			},
 		},
+
+		Timeouts: &schema.ResourceTimeout{
+			Create: schema.DefaultTimeout(60 * time.Minute),
+			Delete: schema.DefaultTimeout(60 * time.Minute),
+		},
 	}
 }
 
@@ -56,7 +61,7 @@ func resourceAbrhaReservedIPAssignmentCreate(ctx context.Context, d *schema.Reso
 			"Error Assigning reserved IP (%s) to the vm: %s", ipAddress, err)
 	}
 
-	_, unassignedErr := waitForReservedIPAssignmentReady(ctx, d, "completed", []string{"new", "in-progress"}, "status", meta, action.ID)
+	_, unassignedErr := waitForReservedIPAssignmentReady(ctx, d, "completed", []string{"new", "in-progress"}, "status", meta, action.ID, d.Timeout(schema.TimeoutCreate))
 	if unassignedErr != nil {
 		return diag.Errorf(
 			"Error waiting for reserved IP (%s) to be Assigned: %s", ipAddress, unassignedErr)
@@ -105,7 +110,7 @@ func resourceAbrhaReservedIPAssignmentDelete(ctx context.Context, d *schema.Reso
 			return diag.Errorf("Error unassigning reserved IP (%s) from the vm: %s", ipAddress, err)
 		}
 
-		_, unassignedErr := waitForReservedIPAssignmentReady(ctx, d, "completed", []string{"new", "in-progress"}, "status", meta, action.ID)
+		_, unassignedErr := waitForReservedIPAssignmentReady(ctx, d, "completed", []string{"new", "in-progress"}, "status", meta, action.ID, d.Timeout(schema.TimeoutDelete))
 		if unassignedErr != nil {
 			return diag.Errorf(
 				"Error waiting for reserved IP (%s) to be unassigned: %s", ipAddress, unassignedErr)
@@ -119,7 +124,7 @@ func resourceAbrhaReservedIPAssignmentDelete(ctx context.Context, d *schema.Reso
 }
 
 func waitForReservedIPAssignmentReady(
-	ctx context.Context, d *schema.ResourceData, target string, pending []string, attribute string, meta interface{}, actionID int) (interface{}, error) {
+	ctx context.Context, d *schema.ResourceData, target string, pending []string, attribute string, meta interface{}, actionID int, timeout time.Duration) (interface{}, error) {
 	log.Printf(
 		"[INFO] Waiting for reserved IP (%s) to have %s of %s",
 		d.Get("ip_address").(string), attribute, target)
@@ -128,7 +133,7 @@ func waitForReservedIPAssignmentReady(
 		Pending:    pending,
 		Target:     []string{target},
 		Refresh:    newReservedIPAssignmentStateRefreshFunc(d, attribute, meta, actionID),
-		Timeout:    60 * time.Minute,
+		Timeout:    timeout,
 		Delay:      10 * time.Second,
 		MinTimeout: 3 * time.Second,
 
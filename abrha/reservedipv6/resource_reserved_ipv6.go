@@ -51,6 +51,10 @@ func ResourceAbrhaReservedIPV6() *schema.Resource {
 				Computed: true,
 			},
 		},
+
+		Timeouts: &schema.ResourceTimeout{
+			Delete: schema.DefaultTimeout(60 * time.Minute),
+		},
 	}
 }
 
@@ -63,7 +67,7 @@ func resourceAbrhaReservedIPV6Create(ctx context.Context, d *schema.ResourceData
 	}
 
 	log.Printf("[DEBUG] Reserved IPv6 create: %#v", regionOpts)
-	reservedIP, _, err := client.ReservedIPV6s.Create(context.Background(), regionOpts)
+	reservedIP, _, err := client.ReservedIPV6s.Create(ctx, regionOpts)
 	if err != nil {
 		return diag.Errorf("Error creating reserved IPv6: %s", err)
 	}
@@ -77,7 +81,7 @@ func resourceAbrhaReservedIPV6Read(ctx context.Context, d *schema.ResourceData,
 	client := meta.(*config.CombinedConfig).GoApiAbrhaClient()
 
 	log.Printf("[INFO] Reading the details of the reserved IPv6 %s", d.Id())
-	reservedIP, resp, err := client.ReservedIPV6s.Get(context.Background(), d.Id())
+	reservedIP, resp, err := client.ReservedIPV6s.Get(ctx, d.Id())
 	if err != nil {
 		if resp != nil && resp.StatusCode == 404 {
 			log.Printf("[WARN] Reserved IPv6 (%s) not found", d.Id())
@@ -106,14 +110,14 @@ func resourceAbrhaReservedIPV6Delete(ctx context.Context, d *schema.ResourceData
 
 	if _, ok := d.GetOk("vm_id"); ok {
 		log.Printf("[INFO] Unassigning the reserved IPv6 from the Vm")
-		action, resp, err := client.ReservedIPV6Actions.Unassign(context.Background(), d.Id())
+		action, resp, err := client.ReservedIPV6Actions.Unassign(ctx, d.Id())
 		if resp.StatusCode != 422 {
 			if err != nil {
 				return diag.Errorf(
 					"Error unassigning reserved IPv6 (%s) from the vm: %s", d.Id(), err)
 			}
 
-			_, unassignedErr := waitForReservedIPV6Ready(ctx, d, "completed", []string{"new", "in-progress"}, "status", meta, action.ID)
+			_, unassignedErr := waitForReservedIPV6Ready(ctx, d, "completed", []string{"new", "in-progress"}, "status", meta, action.ID, d.Timeout(schema.TimeoutDelete))
 			if unassignedErr != nil {
 				return diag.Errorf(
 					"Error waiting for reserved IPv6 (%s) to be unassigned: %s", d.Id(), unassignedErr)
@@ -124,7 +128,7 @@ func resourceAbrhaReservedIPV6Delete(ctx context.Context, d *schema.ResourceData
 	}
 
 	log.Printf("[INFO] Deleting reserved IPv6: %s", d.Id())
-	_, err := client.ReservedIPV6s.Delete(context.Background(), d.Id())
+	_, err := client.ReservedIPV6s.Delete(ctx, d.Id())
 	if err != nil && strings.Contains(err.Error(), "404") {
 		return diag.Errorf("Error deleting reserved IPv6: %s", err)
 	}
@@ -135,7 +139,7 @@ func resourceAbrhaReservedIPV6Delete(ctx context.Context, d *schema.ResourceData
 
 func resourceAbrhaReservedIPV6Import(ctx context.Context, d *schema.ResourceData, meta interface{}) ([]*schema.ResourceData, error) {
 	client := meta.(*config.CombinedConfig).GoApiAbrhaClient()
-	reservedIP, resp, err := client.ReservedIPV6s.Get(context.Background(), d.Id())
+	reservedIP, resp, err := client.ReservedIPV6s.Get(ctx, d.Id())
 	if resp.StatusCode != 404 {
 		if err != nil {
 			return nil, err
@@ -154,7 +158,7 @@ func resourceAbrhaReservedIPV6Import(ctx context.Context, d *schema.ResourceData
 }
 
 func waitForReservedIPV6Ready(
-	ctx context.Context, d *schema.ResourceData, target string, pending []string, attribute string, meta interface{}, actionID int) (interface{}, error) {
+	ctx context.Context, d *schema.ResourceData, target string, pending []string, attribute string, meta interface{}, actionID int, timeout time.Duration) (interface{}, error) {
 	log.Printf(
 		"[INFO] Waiting for reserved IPv6 (%s) to have %s of %s",
 		d.Id(), attribute, target)
@@ -162,8 +166,8 @@ func waitForReservedIPV6Ready(
 	stateConf := &retry.StateChangeConf{
 		Pending:    pending,
 		Target:     []string{target},
-		Refresh:    newReservedIPV6StateRefreshFunc(d, meta, actionID),
-		Timeout:    60 * time.Minute,
+		Refresh:    newReservedIPV6StateRefreshFunc(ctx, d, meta, actionID),
+		Timeout:    timeout,
 		Delay:      10 * time.Second,
 		MinTimeout: 3 * time.Second,
 
@@ -174,12 +178,12 @@ func waitForReservedIPV6Ready(
 }
 
 func newReservedIPV6StateRefreshFunc(
-	d *schema.ResourceData, meta interface{}, actionID int) retry.StateRefreshFunc {
+	ctx context.Context, d *schema.ResourceData, meta interface{}, actionID int) retry.StateRefreshFunc {
 	client := meta.(*config.CombinedConfig).GoApiAbrhaClient()
 	return func() (interface{}, string, error) {
 
 		log.Printf("[INFO] Assigning the reserved IPv6 to the Vm")
-		action, _, err := client.Actions.Get(context.Background(), actionID)
+		action, _, err := client.Actions.Get(ctx, actionID)
 		if err != nil {
 			return nil, "", fmt.Errorf("error retrieving reserved IPv6 (%s) ActionId (%d): %s", d.Id(), actionID, err)
 		}
@@ -40,6 +40,11 @@ func ResourceAbrhaReservedIPV6Assignment() *schema.Resource {
 				ValidateFunc: validation.NoZeroValues,
 			},
 		},
+
+		Timeouts: &schema.ResourceTimeout{
+			Create: schema.DefaultTimeout(60 * time.Minute),
+			Delete: schema.DefaultTimeout(60 * time.Minute),
+		},
 	}
 }
 
@@ -50,13 +55,13 @@ func resourceAbrhaReservedIPV6AssignmentCreate(ctx context.Context, d *schema.Re
 	vmID := d.Get("vm_id").(string)
 
 	log.Printf("[INFO] Assigning the reserved IPv6 (%s) to the Vm %s", ipAddress, vmID)
-	action, _, err := client.ReservedIPV6Actions.Assign(context.Background(), ipAddress, vmID)
+	action, _, err := client.ReservedIPV6Actions.Assign(ctx, ipAddress, vmID)
 	if err != nil {
 		return diag.Errorf(
 			"Error Assigning reserved IPv6 (%s) to the vm: %s", ipAddress, err)
 	}
 
-	_, assignedErr := waitForReservedIPV6AssignmentReady(ctx, d, "completed", []string{"new", "in-progress"}, "status", meta, action.ID)
+	_, assignedErr := waitForReservedIPV6AssignmentReady(ctx, d, "completed", []string{"new", "in-progress"}, "status", meta, action.ID, d.Timeout(schema.TimeoutCreate))
 	if assignedErr != nil {
 		return diag.Errorf(
 			"Error waiting for reserved IPv6 (%s) to be Assigned: %s", ipAddress, assignedErr)
@@ -75,7 +80,7 @@ func resourceAbrhaReservedIPV6AssignmentRead(ctx context.Context, d *schema.Reso
 	vmID := d.Get("vm_id")
 
 	log.Printf("[INFO] Reading the details of the reserved IPv6 %s", ipAddress)
-	reservedIPv6, _, err := client.ReservedIPV6s.Get(context.Background(), ipAddress)
+	reservedIPv6, _, err := client.ReservedIPV6s.Get(ctx, ipAddress)
 	if err != nil {
 		return diag.Errorf("Error retrieving reserved IPv6: %s", err)
 	}
@@ -95,19 +100,19 @@ func resourceAbrhaReservedIPV6AssignmentDelete(ctx context.Context, d *schema.Re
 	vmID := d.Get("vm_id")
 
 	log.Printf("[INFO] Reading the details of the reserved IPv6 %s", ipAddress)
-	reservedIPv6, _, err := client.ReservedIPV6s.Get(context.Background(), ipAddress)
+	reservedIPv6, _, err := client.ReservedIPV6s.Get(ctx, ipAddress)
 	if err != nil {
 		return diag.Errorf("Error retrieving reserved IPv6: %s", err)
 	}
 
 	if reservedIPv6.Vm.ID == vmID {
 		log.Printf("[INFO] Unassigning the reserved IPv6 from the Vm")
-		action, _, err := client.ReservedIPV6Actions.Unassign(context.Background(), ipAddress)
+		action, _, err := client.ReservedIPV6Actions.Unassign(ctx, ipAddress)
 		if err != nil {
 			return diag.Errorf("Error unassigning reserved IPv6 (%s) from the vm: %s", ipAddress, err)
 		}
 
-		_, unassignedErr := waitForReservedIPV6AssignmentReady(ctx, d, "completed", []string{"new", "in-progress"}, "status", meta, action.ID)
+		_, unassignedErr := waitForReservedIPV6AssignmentReady(ctx, d, "completed", []string{"new", "in-progress"}, "status", meta, action.ID, d.Timeout(schema.TimeoutDelete))
 		if unassignedErr != nil {
 			return diag.Errorf(
 				"Error waiting for reserved IPv6 (%s) to be unassigned: %s", ipAddress, unassignedErr)
@@ -121,7 +126,7 @@ func resourceAbrhaReservedIPV6AssignmentDelete(ctx context.Context, d *schema.Re
 }
 
 func waitForReservedIPV6AssignmentReady(
-	ctx context.Context, d *schema.ResourceData, target string, pending []string, attribute string, meta interface{}, actionID int) (interface{}, error) {
+	ctx context.Context, d *schema.ResourceData, target string, pending []string, attribute string, meta interface{}, actionID int, timeout time.Duration) (interface{}, error) {
 	log.Printf(
 		"[INFO] Waiting for reserved IPv6 (%s) to have %s of %s",
 		d.Get("ip").(string), attribute, target)
@@ -129,8 +134,8 @@ func waitForReservedIPV6AssignmentReady(
 	stateConf := &retry.StateChangeConf{
 		Pending:    pending,
 		Target:     []string{target},
-		Refresh:    newReservedIPV6AssignmentStateRefreshFunc(d, meta, actionID),
-		Timeout:    60 * time.Minute,
+		Refresh:    newReservedIPV6AssignmentStateRefreshFunc(ctx, d, meta, actionID),
+		Timeout:    timeout,
 		Delay:      10 * time.Second,
 		MinTimeout: 3 * time.Second,
 
@@ -141,12 +146,12 @@ func waitForReservedIPV6AssignmentReady(
 }
 
 func newReservedIPV6AssignmentStateRefreshFunc(
-	d *schema.ResourceData, meta interface{}, actionID int) retry.StateRefreshFunc {
+	ctx context.Context, d *schema.ResourceData, meta interface{}, actionID int) retry.StateRefreshFunc {
 	client := meta.(*config.CombinedConfig).GoApiAbrhaClient()
 	return func() (interface{}, string, error) {
 
 		log.Printf("[INFO] Refreshing the reserved IPv6 state")
-		action, _, err := client.Actions.Get(context.Background(), actionID)
+		action, _, err := client.Actions.Get(ctx, actionID)
 		if err != nil {
 			return nil, "", fmt.Errorf("error retrieving reserved IPv6 (%s) ActionId (%d): %s", d.Get("ip_address"), actionID, err)
 		}
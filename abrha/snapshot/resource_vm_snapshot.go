@@ -55,6 +55,10 @@ func ResourceAbrhaVmSnapshot() *schema.Resource {
 				Computed: true,
 			},
 		},
+
+		Timeouts: &schema.ResourceTimeout{
+			Create: schema.DefaultTimeout(60 * time.Minute),
+		},
 	}
 }
 
@@ -67,7 +71,7 @@ func resourceAbrhaVmSnapshotCreate(ctx context.Context, d *schema.ResourceData,
 		return diag.Errorf("Error creating Vm Snapshot: %s", err)
 	}
 
-	if err = util.WaitForAction(client, action); err != nil {
+	if err = util.WaitForAction(ctx, client, action, d.Timeout(schema.TimeoutCreate)); err != nil {
 		return diag.Errorf(
 			"Error waiting for Vm snapshot (%v) to finish: %s", resourceId, err)
 	}
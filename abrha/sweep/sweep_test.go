@@ -9,6 +9,7 @@ import (
 	_ "github.com/abrhacom/terraform-provider-abrha/abrha/database"
 	_ "github.com/abrhacom/terraform-provider-abrha/abrha/domain"
 	_ "github.com/abrhacom/terraform-provider-abrha/abrha/firewall"
+	_ "github.com/abrhacom/terraform-provider-abrha/abrha/function"
 	_ "github.com/abrhacom/terraform-provider-abrha/abrha/image"
 	_ "github.com/abrhacom/terraform-provider-abrha/abrha/kubernetes"
 	_ "github.com/abrhacom/terraform-provider-abrha/abrha/loadbalancer"
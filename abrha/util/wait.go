@@ -9,7 +9,8 @@ import (
 )
 
 // WaitForAction waits for the action to finish using the resource.StateChangeConf.
-func WaitForAction(client *goApiAbrha.Client, action *goApiAbrha.Action) error {
+// Polling stops when the timeout elapses or the context is cancelled.
+func WaitForAction(ctx context.Context, client *goApiAbrha.Client, action *goApiAbrha.Action, timeout time.Duration) error {
 	var (
 		pending   = "in-progress"
 		target    = "completed"
@@ -17,7 +18,7 @@ func WaitForAction(client *goApiAbrha.Client, action *goApiAbrha.Action) error {
 			if action.ID == 0 {
 				return action, target, nil
 			}
-			a, _, err := client.Actions.Get(context.Background(), action.ID)
+			a, _, err := client.Actions.Get(ctx, action.ID)
 			if err != nil {
 				return nil, "", err
 			}
@@ -36,13 +37,13 @@ func WaitForAction(client *goApiAbrha.Client, action *goApiAbrha.Action) error {
 		Target:  []string{target},
 
 		Delay:      10 * time.Second,
-		Timeout:    60 * time.Minute,
+		Timeout:    timeout,
 		MinTimeout: 3 * time.Second,
 
 		// This is a hack around DO API strangeness.
 		// https://github.com/hashicorp/terraform/issues/481
 		//
 		NotFoundChecks: 60,
-	}).WaitForStateContext(context.Background())
+	}).WaitForStateContext(ctx)
 	return err
 }
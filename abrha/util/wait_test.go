@@ -0,0 +1,63 @@
+package util
+
+import (
+	"context"
+	"fmt"
+	"net/http"
+	"net/http/httptest"
+	"testing"
+	"time"
+
+	goApiAbrha "github.com/abrhacom/go-api-abrha"
+)
+
+func TestWaitForAction_ContextCancelled(t *testing.T) {
+	t.Parallel()
+
+	server := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		w.Header().Set("Content-Type", "application/json")
+		fmt.Fprint(w, `{"action": {"id": 1, "status": "in-progress"}}`)
+	}))
+	defer server.Close()
+
+	client, err := goApiAbrha.New(nil, goApiAbrha.SetBaseURL(server.URL+"/"))
+	if err != nil {
+		t.Fatalf("error creating client: %s", err)
+	}
+
+	ctx, cancel := context.WithCancel(context.Background())
+	cancel()
+
+	start := time.Now()
+	err = WaitForAction(ctx, client, &goApiAbrha.Action{ID: 1}, time.Hour)
+	if err == nil {
+		t.Fatal("expected an error when the context is cancelled")
+	}
+	if elapsed := time.Since(start); elapsed > 5*time.Second {
+		t.Fatalf("expected polling to stop promptly, took %s", elapsed)
+	}
+}
+
+func TestWaitForAction_Timeout(t *testing.T) {
+	t.Parallel()
+
+	server := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		w.Header().Set("Content-Type", "application/json")
+		fmt.Fprint(w, `{"action": {"id": 1, "status": "in-progress"}}`)
+	}))
+	defer server.Close()
+
+	client, err := goApiAbrha.New(nil, goApiAbrha.SetBaseURL(server.URL+"/"))
+	if err != nil {
+		t.Fatalf("error creating client: %s", err)
+	}
+
+	start := time.Now()
+	err = WaitForAction(context.Background(), client, &goApiAbrha.Action{ID: 1}, time.Second)
+	if err == nil {
+		t.Fatal("expected an error when the timeout elapses")
+	}
+	if elapsed := time.Since(start); elapsed > 5*time.Second {
+		t.Fatalf("expected polling to stop after the timeout, took %s", elapsed)
+	}
+}
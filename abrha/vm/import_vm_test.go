@@ -5,6 +5,7 @@ import (
 	"fmt"
 	"regexp"
 	"testing"
+	"time"
 
 	goApiAbrha "github.com/abrhacom/go-api-abrha"
 	"github.com/abrhacom/terraform-provider-abrha/abrha/acceptance"
@@ -108,7 +109,7 @@ func takeVmSnapshot(t *testing.T, name string, vm *goApiAbrha.Vm, snapshotID *in
 		if err != nil {
 			return err
 		}
-		err = util.WaitForAction(client, action)
+		err = util.WaitForAction(context.Background(), client, action, 60*time.Minute)
 		if err != nil {
 			return err
 		}
@@ -44,7 +44,7 @@ func ResourceAbrhaVm() *schema.Resource {
 		Timeouts: &schema.ResourceTimeout{
 			Create: schema.DefaultTimeout(60 * time.Minute),
 			Update: schema.DefaultTimeout(60 * time.Minute),
-			Delete: schema.DefaultTimeout(60 * time.Second),
+			Delete: schema.DefaultTimeout(60 * time.Minute),
 		},
 
 		Schema: map[string]*schema.Schema{
@@ -405,7 +405,7 @@ func resourceAbrhaVmCreate(ctx context.Context, d *schema.ResourceData, meta int
 	// wait for job to complete
 	actionId := vmRoot.Links.Actions[0].ID
 	log.Printf("[DEBUG] Wating for create vm action (%d) to success...", actionId)
-	if err := util.WaitForAction(client, action); err != nil {
+	if err := util.WaitForAction(ctx, client, action, d.Timeout(schema.TimeoutCreate)); err != nil {
 		return diag.Errorf("Error waiting for create vm action for vm (%s) and action (%d) to complate: %s", d.Id(), actionId, err)
 	}
 
@@ -576,7 +576,7 @@ func resourceAbrhaVmUpdate(ctx context.Context, d *schema.ResourceData, meta int
 		}
 
 		// Wait for the resize action to complete.
-		if err = util.WaitForAction(client, action); err != nil {
+		if err = util.WaitForAction(ctx, client, action, d.Timeout(schema.TimeoutUpdate)); err != nil {
 			newErr := powerOnAndWait(ctx, d, meta)
 			if newErr != nil {
 				return diag.Errorf(
@@ -645,7 +645,7 @@ func resourceAbrhaVmUpdate(ctx context.Context, d *schema.ResourceData, meta int
 						"Error enabling backups on vm (%s): %s", d.Id(), err)
 				}
 			}
-			if err := util.WaitForAction(client, action); err != nil {
+			if err := util.WaitForAction(ctx, client, action, d.Timeout(schema.TimeoutUpdate)); err != nil {
 				return diag.Errorf("Error waiting for backups to be enabled for vm (%s): %s", d.Id(), err)
 			}
 		} else {
@@ -661,7 +661,7 @@ func resourceAbrhaVmUpdate(ctx context.Context, d *schema.ResourceData, meta int
 					"Error disabling backups on vm (%s): %s", d.Id(), err)
 			}
 
-			if err := util.WaitForAction(client, action); err != nil {
+			if err := util.WaitForAction(ctx, client, action, d.Timeout(schema.TimeoutUpdate)); err != nil {
 				return diag.Errorf("Error waiting for backups to be disabled for vm (%s): %s", d.Id(), err)
 			}
 		}
@@ -686,7 +686,7 @@ func resourceAbrhaVmUpdate(ctx context.Context, d *schema.ResourceData, meta int
 					"error changing backup policy on vm (%s): %s", d.Id(), err)
 			}
 
-			if err := util.WaitForAction(client, action); err != nil {
+			if err := util.WaitForAction(ctx, client, action, d.Timeout(schema.TimeoutUpdate)); err != nil {
 				return diag.Errorf("error waiting for backup policy to be changed for vm (%s): %s", d.Id(), err)
 			}
 		}
@@ -765,17 +765,17 @@ func resourceAbrhaVmUpdate(ctx context.Context, d *schema.ResourceData, meta int
 		oldIDSet := newSet(oldIDs.(*schema.Set).List())
 		newIDSet := newSet(newIDs.(*schema.Set).List())
 		for volumeID := range leftDiff(newIDSet, oldIDSet) {
-			action, _, err := client.StorageActions.Attach(context.Background(), volumeID, id)
+			action, _, err := client.StorageActions.Attach(ctx, volumeID, id)
 			if err != nil {
 				return diag.Errorf("Error attaching volume %q to vm (%s): %s", volumeID, d.Id(), err)
 			}
 			// can't fire >1 action at a time, so waiting for each is OK
-			if err := util.WaitForAction(client, action); err != nil {
+			if err := util.WaitForAction(ctx, client, action, d.Timeout(schema.TimeoutUpdate)); err != nil {
 				return diag.Errorf("Error waiting for volume %q to attach to vm (%s): %s", volumeID, d.Id(), err)
 			}
 		}
 		for volumeID := range leftDiff(oldIDSet, newIDSet) {
-			err := detachVolumeIDOnVm(d, volumeID, meta)
+			err := detachVolumeIDOnVm(ctx, d, volumeID, schema.TimeoutUpdate, meta)
 			if err != nil {
 				return diag.Errorf("Error detaching volume %q on vm %s: %s", volumeID, d.Id(), err)
 
@@ -810,7 +810,7 @@ func resourceAbrhaVmDelete(ctx context.Context, d *schema.ResourceData, meta int
 
 		// Shutdown the vm
 		// DO API doesn't return an error if we try to shutdown an already shutdown vm
-		_, _, err = client.VmActions.Shutdown(context.Background(), id)
+		_, _, err = client.VmActions.Shutdown(ctx, id)
 		if err != nil {
 			return diag.Errorf(
 				"Error shutting down the the vm (%s): %s", d.Id(), err)
@@ -824,7 +824,7 @@ func resourceAbrhaVmDelete(ctx context.Context, d *schema.ResourceData, meta int
 	}
 
 	log.Printf("[INFO] Trying to Detach Storage Volumes (if any) from vm: %s", d.Id())
-	err = detachVolumesFromVm(d, meta)
+	err = detachVolumesFromVm(ctx, d, schema.TimeoutDelete, meta)
 	if err != nil {
 		return diag.Errorf(
 			"Error detaching the volumes from the vm (%s): %s", d.Id(), err)
@@ -833,10 +833,10 @@ func resourceAbrhaVmDelete(ctx context.Context, d *schema.ResourceData, meta int
 	log.Printf("[INFO] Deleting vm: %s", d.Id())
 
 	// Destroy the vm
-	resp, err := client.Vms.Delete(context.Background(), id)
+	resp, err := client.Vms.Delete(ctx, id)
 
 	// Handle already destroyed vms
-	if err != nil && resp.StatusCode == 404 {
+	if err != nil && resp != nil && resp.StatusCode == 404 {
 		return nil
 	}
 
@@ -857,7 +857,7 @@ func waitForVmDestroy(ctx context.Context, d *schema.ResourceData, meta interfac
 		Pending:    []string{"active", "off"},
 		Target:     []string{"archived"},
 		Refresh:    vmStateRefreshFunc(ctx, d, "status", meta),
-		Timeout:    60 * time.Second,
+		Timeout:    d.Timeout(schema.TimeoutDelete),
 		Delay:      10 * time.Second,
 		MinTimeout: 3 * time.Second,
 	}
@@ -936,7 +936,7 @@ func powerOnAndWait(ctx context.Context, d *schema.ResourceData, meta interface{
 	id := d.Id()
 
 	client := meta.(*config.CombinedConfig).GoApiAbrhaClient()
-	_, _, err := client.VmActions.PowerOn(context.Background(), id)
+	_, _, err := client.VmActions.PowerOn(ctx, id)
 	if err != nil {
 		return err
 	}
@@ -951,12 +951,12 @@ func powerOnAndWait(ctx context.Context, d *schema.ResourceData, meta interface{
 }
 
 // Detach volumes from vm
-func detachVolumesFromVm(d *schema.ResourceData, meta interface{}) error {
+func detachVolumesFromVm(ctx context.Context, d *schema.ResourceData, timeoutKey string, meta interface{}) error {
 	var errors []error
 	if attr, ok := d.GetOk("volume_ids"); ok {
 		errors = make([]error, 0, attr.(*schema.Set).Len())
 		for _, volumeID := range attr.(*schema.Set).List() {
-			err := detachVolumeIDOnVm(d, volumeID.(string), meta)
+			err := detachVolumeIDOnVm(ctx, d, volumeID.(string), timeoutKey, meta)
 			if err != nil {
 				return err
 			}
@@ -970,16 +970,16 @@ func detachVolumesFromVm(d *schema.ResourceData, meta interface{}) error {
 	return nil
 }
 
-func detachVolumeIDOnVm(d *schema.ResourceData, volumeID string, meta interface{}) error {
+func detachVolumeIDOnVm(ctx context.Context, d *schema.ResourceData, volumeID string, timeoutKey string, meta interface{}) error {
 	id := d.Id()
 
 	client := meta.(*config.CombinedConfig).GoApiAbrhaClient()
-	action, _, err := client.StorageActions.DetachByVmID(context.Background(), volumeID, id)
+	action, _, err := client.StorageActions.DetachByVmID(ctx, volumeID, id)
 	if err != nil {
 		return fmt.Errorf("Error detaching volume %q from vm (%s): %s", volumeID, d.Id(), err)
 	}
 	// can't fire >1 action at a time, so waiting for each is OK
-	if err := util.WaitForAction(client, action); err != nil {
+	if err := util.WaitForAction(ctx, client, action, d.Timeout(timeoutKey)); err != nil {
 		return fmt.Errorf("Error waiting for volume %q to detach from vm (%s): %s", volumeID, d.Id(), err)
 	}
 
@@ -0,0 +1,235 @@
+package vm
+
+import (
+	"context"
+	"fmt"
+	"log"
+	"strconv"
+	"time"
+
+	goApiAbrha "github.com/abrhacom/go-api-abrha"
+	"github.com/abrhacom/terraform-provider-abrha/abrha/config"
+	"github.com/abrhacom/terraform-provider-abrha/abrha/util"
+	"github.com/abrhacom/terraform-provider-abrha/internal/mutexkv"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/diag"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/schema"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/validation"
+)
+
+// mutexKV serializes actions performed against the same VM, as the API
+// rejects a new action while another one is still in progress.
+var mutexKV = mutexkv.NewMutexKV()
+
+const (
+	vmActionReboot        = "reboot"
+	vmActionPowerCycle    = "power_cycle"
+	vmActionPasswordReset = "password_reset"
+	vmActionRebuild       = "rebuild"
+	vmActionChangeKernel  = "change_kernel"
+	vmActionRestore       = "restore"
+)
+
+func ResourceAbrhaVmAction() *schema.Resource {
+	return &schema.Resource{
+		CreateContext: resourceAbrhaVmActionCreate,
+		ReadContext:   resourceAbrhaVmActionRead,
+		DeleteContext: resourceAbrhaVmActionDelete,
+
+		Schema: map[string]*schema.Schema{
+			"vm_id": {
+				Type:         schema.TypeString,
+				Required:     true,
+				ForceNew:     true,
+				ValidateFunc: validation.NoZeroValues,
+			},
+			"type": {
+				Type:     schema.TypeString,
+				Required: true,
+				ForceNew: true,
+				ValidateFunc: validation.StringInSlice([]string{
+					vmActionReboot,
+					vmActionPowerCycle,
+					vmActionPasswordReset,
+					vmActionRebuild,
+					vmActionChangeKernel,
+					vmActionRestore,
+				}, false),
+			},
+			"image": {
+				Type:         schema.TypeString,
+				Optional:     true,
+				ForceNew:     true,
+				ValidateFunc: validation.NoZeroValues,
+				Description:  "The image ID or slug to rebuild from, or the ID of the backup or snapshot to restore",
+			},
+			"kernel_id": {
+				Type:         schema.TypeInt,
+				Optional:     true,
+				ForceNew:     true,
+				ValidateFunc: validation.IntAtLeast(1),
+				Description:  "The ID of the kernel to switch to",
+			},
+			"triggers": {
+				Type:        schema.TypeMap,
+				Optional:    true,
+				ForceNew:    true,
+				Elem:        &schema.Schema{Type: schema.TypeString},
+				Description: "Arbitrary map of values that, when changed, will perform the action again",
+			},
+
+			// Computed attributes
+			"action_id": {
+				Type:     schema.TypeInt,
+				Computed: true,
+			},
+			"status": {
+				Type:     schema.TypeString,
+				Computed: true,
+			},
+			"started_at": {
+				Type:     schema.TypeString,
+				Computed: true,
+			},
+			"completed_at": {
+				Type:     schema.TypeString,
+				Computed: true,
+			},
+		},
+
+		Timeouts: &schema.ResourceTimeout{
+			Create: schema.DefaultTimeout(60 * time.Minute),
+		},
+
+		CustomizeDiff: validateVmActionArguments,
+	}
+}
+
+func validateVmActionArguments(ctx context.Context, diff *schema.ResourceDiff, v interface{}) error {
+	actionType := diff.Get("type").(string)
+	_, hasImage := diff.GetOk("image")
+	_, hasKernel := diff.GetOk("kernel_id")
+
+	switch actionType {
+	case vmActionRebuild:
+		if !hasImage && diff.NewValueKnown("image") {
+			return fmt.Errorf("image is required for the %s action", actionType)
+		}
+	case vmActionRestore:
+		if !hasImage && diff.NewValueKnown("image") {
+			return fmt.Errorf("image is required for the %s action", actionType)
+		}
+		if image, ok := diff.GetOk("image"); ok {
+			if _, err := strconv.Atoi(image.(string)); err != nil {
+				return fmt.Errorf("image must be the numeric ID of a backup or snapshot for the %s action", actionType)
+			}
+		}
+	case vmActionChangeKernel:
+		if !hasKernel && diff.NewValueKnown("kernel_id") {
+			return fmt.Errorf("kernel_id is required for the %s action", actionType)
+		}
+	default:
+		if hasImage || hasKernel {
+			return fmt.Errorf("image and kernel_id are not supported for the %s action", actionType)
+		}
+	}
+
+	return nil
+}
+
+func resourceAbrhaVmActionCreate(ctx context.Context, d *schema.ResourceData, meta interface{}) diag.Diagnostics {
+	client := meta.(*config.CombinedConfig).GoApiAbrhaClient()
+	vmID := d.Get("vm_id").(string)
+	actionType := d.Get("type").(string)
+
+	mutexKV.Lock(vmID)
+	defer mutexKV.Unlock(vmID)
+
+	log.Printf("[INFO] Performing %s action on vm (%s)", actionType, vmID)
+	action, err := performVmAction(client, d, vmID, actionType)
+	if err != nil {
+		return diag.Errorf("Error performing %s action on vm (%s): %s", actionType, vmID, err)
+	}
+
+	d.SetId(strconv.Itoa(action.ID))
+	d.Set("action_id", action.ID)
+
+	if err := util.WaitForAction(ctx, client, action, d.Timeout(schema.TimeoutCreate)); err != nil {
+		return diag.Errorf("Error waiting for %s action on vm (%s) to finish: %s", actionType, vmID, err)
+	}
+
+	return resourceAbrhaVmActionRead(ctx, d, meta)
+}
+
+func resourceAbrhaVmActionRead(ctx context.Context, d *schema.ResourceData, meta interface{}) diag.Diagnostics {
+	client := meta.(*config.CombinedConfig).GoApiAbrhaClient()
+	vmID := d.Get("vm_id").(string)
+
+	// The action only remains meaningful as long as the VM it was performed on
+	// still exists.
+	_, resp, err := client.Vms.Get(context.Background(), vmID)
+	if err != nil {
+		if resp != nil && resp.StatusCode == 404 {
+			log.Printf("[WARN] Vm (%s) not found, removing action %s from state", vmID, d.Id())
+			d.SetId("")
+			return nil
+		}
+
+		return diag.Errorf("Error retrieving vm: %s", err)
+	}
+
+	action, _, err := client.Actions.Get(context.Background(), d.Get("action_id").(int))
+	if err != nil {
+		return diag.Errorf("Error retrieving vm action: %s", err)
+	}
+
+	d.Set("status", action.Status)
+	d.Set("started_at", formatActionTimestamp(action.StartedAt))
+	d.Set("completed_at", formatActionTimestamp(action.CompletedAt))
+
+	return nil
+}
+
+func resourceAbrhaVmActionDelete(ctx context.Context, d *schema.ResourceData, meta interface{}) diag.Diagnostics {
+	// Actions cannot be undone; removing the resource only drops it from state.
+	d.SetId("")
+	return nil
+}
+
+func performVmAction(client *goApiAbrha.Client, d *schema.ResourceData, vmID string, actionType string) (*goApiAbrha.Action, error) {
+	var (
+		action *goApiAbrha.Action
+		err    error
+	)
+
+	switch actionType {
+	case vmActionReboot:
+		action, _, err = client.VmActions.Reboot(context.Background(), vmID)
+	case vmActionPowerCycle:
+		action, _, err = client.VmActions.PowerCycle(context.Background(), vmID)
+	case vmActionPasswordReset:
+		action, _, err = client.VmActions.PasswordReset(context.Background(), vmID)
+	case vmActionRebuild:
+		image := d.Get("image").(string)
+		if imageID, convErr := strconv.Atoi(image); convErr == nil {
+			action, _, err = client.VmActions.RebuildByImageID(context.Background(), vmID, imageID)
+		} else {
+			action, _, err = client.VmActions.RebuildByImageSlug(context.Background(), vmID, image)
+		}
+	case vmActionChangeKernel:
+		action, _, err = client.VmActions.ChangeKernel(context.Background(), vmID, d.Get("kernel_id").(int))
+	case vmActionRestore:
+		imageID, convErr := strconv.Atoi(d.Get("image").(string))
+		if convErr != nil {
+			return nil, fmt.Errorf("invalid image ID: %s", convErr)
+		}
+		action, _, err = client.VmActions.Restore(context.Background(), vmID, imageID)
+	default:
+		return nil, fmt.Errorf("unsupported action type: %s", actionType)
+	}
+
+	if err != nil {
+		return nil, err
+	}
+
+	return action, nil
+}
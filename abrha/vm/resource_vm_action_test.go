@@ -0,0 +1,117 @@
+package vm_test
+
+import (
+	"fmt"
+	"regexp"
+	"testing"
+
+	goApiAbrha "github.com/abrhacom/go-api-abrha"
+	"github.com/abrhacom/terraform-provider-abrha/abrha/acceptance"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/resource"
+)
+
+func TestAccAbrhaVmAction_Reboot(t *testing.T) {
+	var vm goApiAbrha.Vm
+	name := acceptance.RandomTestName()
+
+	resource.ParallelTest(t, resource.TestCase{
+		PreCheck:          func() { acceptance.TestAccPreCheck(t) },
+		ProviderFactories: acceptance.TestAccProviderFactories,
+		CheckDestroy:      acceptance.TestAccCheckAbrhaVmDestroy,
+		Steps: []resource.TestStep{
+			{
+				Config: testAccCheckAbrhaVmActionConfig_reboot(name, "1"),
+				Check: resource.ComposeTestCheckFunc(
+					acceptance.TestAccCheckAbrhaVmExists("abrha_vm.foobar", &vm),
+					resource.TestCheckResourceAttr("abrha_vm_action.foobar", "type", "reboot"),
+					resource.TestCheckResourceAttr("abrha_vm_action.foobar", "status", "completed"),
+					resource.TestCheckResourceAttrSet("abrha_vm_action.foobar", "action_id"),
+					resource.TestCheckResourceAttrSet("abrha_vm_action.foobar", "completed_at"),
+					resource.TestCheckResourceAttrPair("abrha_vm_action.foobar", "vm_id", "abrha_vm.foobar", "id"),
+				),
+			},
+			{
+				Config: testAccCheckAbrhaVmActionConfig_reboot(name, "2"),
+				Check: resource.ComposeTestCheckFunc(
+					resource.TestCheckResourceAttr("abrha_vm_action.foobar", "triggers.run", "2"),
+					resource.TestCheckResourceAttr("abrha_vm_action.foobar", "status", "completed"),
+				),
+			},
+		},
+	})
+}
+
+func TestAccAbrhaVmAction_Rebuild(t *testing.T) {
+	var vm goApiAbrha.Vm
+	name := acceptance.RandomTestName()
+
+	resource.ParallelTest(t, resource.TestCase{
+		PreCheck:          func() { acceptance.TestAccPreCheck(t) },
+		ProviderFactories: acceptance.TestAccProviderFactories,
+		CheckDestroy:      acceptance.TestAccCheckAbrhaVmDestroy,
+		Steps: []resource.TestStep{
+			{
+				Config: testAccCheckAbrhaVmActionConfig_rebuild(name, "ubuntu-24-04-x64"),
+				Check: resource.ComposeTestCheckFunc(
+					acceptance.TestAccCheckAbrhaVmExists("abrha_vm.foobar", &vm),
+					resource.TestCheckResourceAttr("abrha_vm_action.foobar", "type", "rebuild"),
+					resource.TestCheckResourceAttr("abrha_vm_action.foobar", "image", "ubuntu-24-04-x64"),
+					resource.TestCheckResourceAttr("abrha_vm_action.foobar", "status", "completed"),
+				),
+			},
+		},
+	})
+}
+
+func TestAccAbrhaVmAction_MissingImage(t *testing.T) {
+	name := acceptance.RandomTestName()
+
+	resource.ParallelTest(t, resource.TestCase{
+		PreCheck:          func() { acceptance.TestAccPreCheck(t) },
+		ProviderFactories: acceptance.TestAccProviderFactories,
+		Steps: []resource.TestStep{
+			{
+				Config: fmt.Sprintf(`%s
+
+resource "abrha_vm_action" "foobar" {
+  vm_id = abrha_vm.foobar.id
+  type  = "restore"
+}`, acceptance.TestAccCheckAbrhaVmConfig_basic(name)),
+				ExpectError: regexp.MustCompile("image is required for the restore action"),
+			},
+		},
+	})
+}
+
+func testAccCheckAbrhaVmActionConfig_reboot(name string, run string) string {
+	return fmt.Sprintf(`%s
+
+resource "abrha_vm_action" "foobar" {
+  vm_id = abrha_vm.foobar.id
+  type  = "reboot"
+
+  triggers = {
+    run = "%s"
+  }
+}`, acceptance.TestAccCheckAbrhaVmConfig_basic(name), run)
+}
+
+func testAccCheckAbrhaVmActionConfig_rebuild(name string, image string) string {
+	return fmt.Sprintf(`
+resource "abrha_vm" "foobar" {
+  name   = "%s"
+  size   = "%s"
+  image  = "%s"
+  region = "nyc3"
+
+  lifecycle {
+    ignore_changes = [image]
+  }
+}
+
+resource "abrha_vm_action" "foobar" {
+  vm_id = abrha_vm.foobar.id
+  type  = "rebuild"
+  image = "%s"
+}`, name, defaultSize, defaultImage, image)
+}
@@ -0,0 +1,295 @@
+package vm
+
+import (
+	"context"
+	"fmt"
+	"log"
+	"sort"
+	"strings"
+	"sync"
+	"time"
+
+	goApiAbrha "github.com/abrhacom/go-api-abrha"
+	"github.com/abrhacom/terraform-provider-abrha/abrha/config"
+	"github.com/abrhacom/terraform-provider-abrha/abrha/tag"
+	"github.com/abrhacom/terraform-provider-abrha/abrha/util"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/diag"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/id"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/schema"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/validation"
+)
+
+const (
+	vmTagActionShutdown      = "shutdown"
+	vmTagActionPowerOff      = "power_off"
+	vmTagActionPowerOn       = "power_on"
+	vmTagActionSnapshot      = "snapshot"
+	vmTagActionEnableBackups = "enable_backups"
+	vmTagActionEnableIPv6    = "enable_ipv6"
+)
+
+func ResourceAbrhaVmTagAction() *schema.Resource {
+	return &schema.Resource{
+		CreateContext: resourceAbrhaVmTagActionCreate,
+		ReadContext:   resourceAbrhaVmTagActionRead,
+		DeleteContext: resourceAbrhaVmTagActionDelete,
+
+		Schema: map[string]*schema.Schema{
+			"tag": {
+				Type:         schema.TypeString,
+				Required:     true,
+				ForceNew:     true,
+				ValidateFunc: tag.ValidateTag,
+			},
+			"type": {
+				Type:     schema.TypeString,
+				Required: true,
+				ForceNew: true,
+				ValidateFunc: validation.StringInSlice([]string{
+					vmTagActionShutdown,
+					vmTagActionPowerOff,
+					vmTagActionPowerOn,
+					vmTagActionSnapshot,
+					vmTagActionEnableBackups,
+					vmTagActionEnableIPv6,
+				}, false),
+			},
+			"snapshot_name": {
+				Type:         schema.TypeString,
+				Optional:     true,
+				ForceNew:     true,
+				ValidateFunc: validation.NoZeroValues,
+				Description:  "The name given to the snapshot of each VM when type is snapshot",
+			},
+			"triggers": {
+				Type:        schema.TypeMap,
+				Optional:    true,
+				ForceNew:    true,
+				Elem:        &schema.Schema{Type: schema.TypeString},
+				Description: "Arbitrary map of values that, when changed, will perform the action again",
+			},
+
+			// Computed attributes
+			"actions": {
+				Type:        schema.TypeList,
+				Computed:    true,
+				Description: "The outcome of the action for each VM carrying the tag",
+				Elem: &schema.Resource{
+					Schema: map[string]*schema.Schema{
+						"action_id": {
+							Type:     schema.TypeInt,
+							Computed: true,
+						},
+						"vm_id": {
+							Type:     schema.TypeString,
+							Computed: true,
+						},
+						"status": {
+							Type:     schema.TypeString,
+							Computed: true,
+						},
+						"started_at": {
+							Type:     schema.TypeString,
+							Computed: true,
+						},
+						"completed_at": {
+							Type:     schema.TypeString,
+							Computed: true,
+						},
+						"error": {
+							Type:     schema.TypeString,
+							Computed: true,
+						},
+					},
+				},
+			},
+		},
+
+		Timeouts: &schema.ResourceTimeout{
+			Create: schema.DefaultTimeout(60 * time.Minute),
+		},
+
+		CustomizeDiff: validateVmTagActionArguments,
+	}
+}
+
+func validateVmTagActionArguments(ctx context.Context, diff *schema.ResourceDiff, v interface{}) error {
+	actionType := diff.Get("type").(string)
+	_, hasName := diff.GetOk("snapshot_name")
+
+	if actionType == vmTagActionSnapshot {
+		if !hasName && diff.NewValueKnown("snapshot_name") {
+			return fmt.Errorf("snapshot_name is required for the %s action", actionType)
+		}
+	} else if hasName {
+		return fmt.Errorf("snapshot_name is not supported for the %s action", actionType)
+	}
+
+	return nil
+}
+
+func resourceAbrhaVmTagActionCreate(ctx context.Context, d *schema.ResourceData, meta interface{}) diag.Diagnostics {
+	client := meta.(*config.CombinedConfig).GoApiAbrhaClient()
+	tagName := d.Get("tag").(string)
+	actionType := d.Get("type").(string)
+
+	log.Printf("[INFO] Performing %s action on vms tagged %s", actionType, tagName)
+	actions, err := performVmTagAction(client, d, tagName, actionType)
+	if err != nil {
+		return diag.Errorf("Error performing %s action on vms tagged %s: %s", actionType, tagName, err)
+	}
+
+	d.SetId(id.PrefixedUniqueId(tagName + "-"))
+	log.Printf("[INFO] Started %d %s action(s) on vms tagged %s", len(actions), actionType, tagName)
+
+	outcomes := waitForVmActions(ctx, client, actions, d.Timeout(schema.TimeoutCreate))
+	d.Set("actions", flattenVmActionOutcomes(outcomes))
+
+	var failed []string
+	for _, o := range outcomes {
+		if o.err != nil {
+			failed = append(failed, fmt.Sprintf("  - vm %s (action %d): %s", o.action.ResourceID, o.action.ID, o.err))
+		}
+	}
+	if len(failed) > 0 {
+		return diag.Errorf("%d of %d %s action(s) on vms tagged %s failed:\n%s",
+			len(failed), len(outcomes), actionType, tagName, strings.Join(failed, "\n"))
+	}
+
+	return nil
+}
+
+func resourceAbrhaVmTagActionRead(ctx context.Context, d *schema.ResourceData, meta interface{}) diag.Diagnostics {
+	client := meta.(*config.CombinedConfig).GoApiAbrhaClient()
+
+	// Refresh the status of each action. Failures recorded during create are
+	// kept, and actions that are no longer retained keep their last known state.
+	outcomes := d.Get("actions").([]interface{})
+	for _, raw := range outcomes {
+		outcome := raw.(map[string]interface{})
+
+		action, resp, err := client.Actions.Get(context.Background(), outcome["action_id"].(int))
+		if err != nil {
+			if resp != nil && resp.StatusCode == 404 {
+				continue
+			}
+
+			return diag.Errorf("Error retrieving vm action: %s", err)
+		}
+
+		outcome["status"] = action.Status
+		outcome["started_at"] = formatActionTimestamp(action.StartedAt)
+		outcome["completed_at"] = formatActionTimestamp(action.CompletedAt)
+	}
+
+	d.Set("actions", outcomes)
+
+	return nil
+}
+
+func resourceAbrhaVmTagActionDelete(ctx context.Context, d *schema.ResourceData, meta interface{}) diag.Diagnostics {
+	// Actions cannot be undone; removing the resource only drops it from state.
+	d.SetId("")
+	return nil
+}
+
+func performVmTagAction(client *goApiAbrha.Client, d *schema.ResourceData, tagName string, actionType string) ([]goApiAbrha.Action, error) {
+	var (
+		actions []goApiAbrha.Action
+		err     error
+	)
+
+	switch actionType {
+	case vmTagActionShutdown:
+		actions, _, err = client.VmActions.ShutdownByTag(context.Background(), tagName)
+	case vmTagActionPowerOff:
+		actions, _, err = client.VmActions.PowerOffByTag(context.Background(), tagName)
+	case vmTagActionPowerOn:
+		actions, _, err = client.VmActions.PowerOnByTag(context.Background(), tagName)
+	case vmTagActionSnapshot:
+		actions, _, err = client.VmActions.SnapshotByTag(context.Background(), tagName, d.Get("snapshot_name").(string))
+	case vmTagActionEnableBackups:
+		actions, _, err = client.VmActions.EnableBackupsByTag(context.Background(), tagName)
+	case vmTagActionEnableIPv6:
+		actions, _, err = client.VmActions.EnableIPv6ByTag(context.Background(), tagName)
+	default:
+		return nil, fmt.Errorf("unsupported action type: %s", actionType)
+	}
+
+	if err != nil {
+		return nil, err
+	}
+
+	return actions, nil
+}
+
+type vmActionOutcome struct {
+	action *goApiAbrha.Action
+	err    error
+}
+
+// waitForVmActions waits for all of the given actions concurrently and returns
+// their final state, ordered by VM ID.
+func waitForVmActions(ctx context.Context, client *goApiAbrha.Client, actions []goApiAbrha.Action, timeout time.Duration) []vmActionOutcome {
+	outcomes := make([]vmActionOutcome, len(actions))
+
+	var wg sync.WaitGroup
+	for i := range actions {
+		wg.Add(1)
+		go func(i int) {
+			defer wg.Done()
+
+			action := &actions[i]
+			outcome := vmActionOutcome{action: action}
+			if err := util.WaitForAction(ctx, client, action, timeout); err != nil {
+				outcome.err = err
+			}
+
+			// Pick up the final status and timestamps of the action.
+			if a, _, err := client.Actions.Get(ctx, action.ID); err == nil {
+				outcome.action = a
+			}
+			if outcome.err == nil && outcome.action.Status == "errored" {
+				outcome.err = fmt.Errorf("action errored")
+			}
+
+			outcomes[i] = outcome
+		}(i)
+	}
+	wg.Wait()
+
+	sort.Slice(outcomes, func(i, j int) bool {
+		return outcomes[i].action.ResourceID < outcomes[j].action.ResourceID
+	})
+
+	return outcomes
+}
+
+func flattenVmActionOutcomes(outcomes []vmActionOutcome) []interface{} {
+	result := make([]interface{}, 0, len(outcomes))
+	for _, o := range outcomes {
+		errMsg := ""
+		if o.err != nil {
+			errMsg = o.err.Error()
+		}
+
+		result = append(result, map[string]interface{}{
+			"action_id":    o.action.ID,
+			"vm_id":        o.action.ResourceID,
+			"status":       o.action.Status,
+			"started_at":   formatActionTimestamp(o.action.StartedAt),
+			"completed_at": formatActionTimestamp(o.action.CompletedAt),
+			"error":        errMsg,
+		})
+	}
+
+	return result
+}
+
+func formatActionTimestamp(ts *goApiAbrha.Timestamp) string {
+	if ts == nil {
+		return ""
+	}
+
+	return ts.UTC().Format(time.RFC3339)
+}
@@ -0,0 +1,76 @@
+package vm_test
+
+import (
+	"fmt"
+	"regexp"
+	"testing"
+
+	"github.com/abrhacom/terraform-provider-abrha/abrha/acceptance"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/resource"
+)
+
+func TestAccAbrhaVmTagAction_Snapshot(t *testing.T) {
+	name := acceptance.RandomTestName()
+	tagName := acceptance.RandomTestName("tag")
+
+	resource.ParallelTest(t, resource.TestCase{
+		PreCheck:          func() { acceptance.TestAccPreCheck(t) },
+		ProviderFactories: acceptance.TestAccProviderFactories,
+		CheckDestroy:      acceptance.TestAccCheckAbrhaVmDestroy,
+		Steps: []resource.TestStep{
+			{
+				Config: testAccCheckAbrhaVmTagActionConfig_snapshot(name, tagName),
+				Check: resource.ComposeTestCheckFunc(
+					resource.TestCheckResourceAttr("abrha_vm_tag_action.foobar", "type", "snapshot"),
+					resource.TestCheckResourceAttr("abrha_vm_tag_action.foobar", "actions.#", "2"),
+					resource.TestCheckResourceAttr("abrha_vm_tag_action.foobar", "actions.0.status", "completed"),
+					resource.TestCheckResourceAttr("abrha_vm_tag_action.foobar", "actions.0.error", ""),
+					resource.TestCheckResourceAttr("abrha_vm_tag_action.foobar", "actions.1.status", "completed"),
+					resource.TestCheckResourceAttrSet("abrha_vm_tag_action.foobar", "actions.0.vm_id"),
+					resource.TestCheckResourceAttrSet("abrha_vm_tag_action.foobar", "actions.1.action_id"),
+				),
+			},
+		},
+	})
+}
+
+func TestAccAbrhaVmTagAction_MissingSnapshotName(t *testing.T) {
+	resource.ParallelTest(t, resource.TestCase{
+		PreCheck:          func() { acceptance.TestAccPreCheck(t) },
+		ProviderFactories: acceptance.TestAccProviderFactories,
+		Steps: []resource.TestStep{
+			{
+				Config: `
+resource "abrha_vm_tag_action" "foobar" {
+  tag  = "production"
+  type = "snapshot"
+}`,
+				ExpectError: regexp.MustCompile("snapshot_name is required for the snapshot action"),
+			},
+		},
+	})
+}
+
+func testAccCheckAbrhaVmTagActionConfig_snapshot(name string, tagName string) string {
+	return fmt.Sprintf(`
+resource "abrha_tag" "foobar" {
+  name = "%[2]s"
+}
+
+resource "abrha_vm" "foobar" {
+  count  = 2
+  name   = "%[1]s-${count.index}"
+  size   = "%[3]s"
+  image  = "%[4]s"
+  region = "nyc3"
+  tags   = [abrha_tag.foobar.id]
+}
+
+resource "abrha_vm_tag_action" "foobar" {
+  tag           = abrha_tag.foobar.name
+  type          = "snapshot"
+  snapshot_name = "%[1]s-snapshot"
+
+  depends_on = [abrha_vm.foobar]
+}`, name, tagName, defaultSize, defaultImage)
+}
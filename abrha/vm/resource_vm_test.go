@@ -66,6 +66,45 @@ func TestAccAbrhaVm_Basic(t *testing.T) {
 	})
 }
 
+func TestAccAbrhaVm_FakeAPI(t *testing.T) {
+	var vm goApiAbrha.Vm
+	name := acceptance.RandomTestName()
+	acceptance.UseFakeAPI(t)
+
+	resource.Test(t, resource.TestCase{
+		PreCheck:          func() { acceptance.TestAccPreCheck(t) },
+		ProviderFactories: acceptance.TestAccProviderFactories,
+		CheckDestroy:      acceptance.TestAccCheckAbrhaVmDestroy,
+		Steps: []resource.TestStep{
+			{
+				Config: acceptance.TestAccCheckAbrhaVmConfig_basic(name),
+				Check: resource.ComposeTestCheckFunc(
+					acceptance.TestAccCheckAbrhaVmExists("abrha_vm.foobar", &vm),
+					testAccCheckAbrhaVmAttributes(&vm),
+					resource.TestCheckResourceAttr(
+						"abrha_vm.foobar", "name", name),
+					resource.TestCheckResourceAttr(
+						"abrha_vm.foobar", "status", "active"),
+					resource.TestCheckResourceAttrSet(
+						"abrha_vm.foobar", "ipv4_address"),
+					resource.TestCheckResourceAttrSet(
+						"abrha_vm.foobar", "vpc_uuid"),
+				),
+			},
+			{
+				Config: testAccCheckAbrhaVmConfig_RenameAndResize(name + "-renamed"),
+				Check: resource.ComposeTestCheckFunc(
+					acceptance.TestAccCheckAbrhaVmExists("abrha_vm.foobar", &vm),
+					resource.TestCheckResourceAttr(
+						"abrha_vm.foobar", "name", name+"-renamed"),
+					resource.TestCheckResourceAttr(
+						"abrha_vm.foobar", "size", "s-1vcpu-2gb"),
+				),
+			},
+		},
+	})
+}
+
 func TestAccAbrhaVm_WithID(t *testing.T) {
 	var vm goApiAbrha.Vm
 	name := acceptance.RandomTestName()
@@ -0,0 +1,31 @@
+package volume
+
+import (
+	"strings"
+
+	"github.com/abrhacom/terraform-provider-abrha/internal/datalist"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/schema"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/validation"
+)
+
+func DataSourceAbrhaVolumes() *schema.Resource {
+	dataListConfig := &datalist.ResourceConfig{
+		RecordSchema:        volumeSchema(),
+		ResultAttributeName: "volumes",
+		GetRecords:          getAbrhaVolumes,
+		FlattenRecord:       flattenAbrhaVolume,
+		ExtraQuerySchema: map[string]*schema.Schema{
+			"region": {
+				Type:        schema.TypeString,
+				Optional:    true,
+				Description: "only list volumes in this region",
+				StateFunc: func(val interface{}) string {
+					return strings.ToLower(val.(string))
+				},
+				ValidateFunc: validation.NoZeroValues,
+			},
+		},
+	}
+
+	return datalist.NewResource(dataListConfig)
+}
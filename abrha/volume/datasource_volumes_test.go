@@ -0,0 +1,64 @@
+package volume_test
+
+import (
+	"fmt"
+	"testing"
+
+	"github.com/abrhacom/terraform-provider-abrha/abrha/acceptance"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/resource"
+)
+
+func TestAccDataSourceAbrhaVolumes_Basic(t *testing.T) {
+	testName1 := acceptance.RandomTestName("volume")
+	testName2 := acceptance.RandomTestName("volume")
+	tagName := acceptance.RandomTestName("tag")
+
+	resourcesConfig := fmt.Sprintf(`
+resource "abrha_volume" "foo" {
+  region = "nyc3"
+  name   = "%s"
+  size   = 10
+  tags   = ["%s"]
+}
+
+resource "abrha_volume" "bar" {
+  region = "nyc3"
+  name   = "%s"
+  size   = 20
+}
+`, testName1, tagName, testName2)
+
+	datasourceConfig := fmt.Sprintf(`
+data "abrha_volumes" "result" {
+  region = "nyc3"
+
+  filter {
+    key    = "tags"
+    values = ["%s"]
+  }
+}
+`, tagName)
+
+	resource.ParallelTest(t, resource.TestCase{
+		PreCheck:          func() { acceptance.TestAccPreCheck(t) },
+		ProviderFactories: acceptance.TestAccProviderFactories,
+		Steps: []resource.TestStep{
+			{
+				Config: resourcesConfig,
+			},
+			{
+				Config: resourcesConfig + datasourceConfig,
+				Check: resource.ComposeTestCheckFunc(
+					resource.TestCheckResourceAttr("data.abrha_volumes.result", "volumes.#", "1"),
+					resource.TestCheckResourceAttr("data.abrha_volumes.result", "volumes.0.name", testName1),
+					resource.TestCheckResourceAttr("data.abrha_volumes.result", "volumes.0.size", "10"),
+					resource.TestCheckResourceAttr("data.abrha_volumes.result", "volumes.0.region", "nyc3"),
+					resource.TestCheckResourceAttrPair("data.abrha_volumes.result", "volumes.0.id", "abrha_volume.foo", "id"),
+				),
+			},
+			{
+				Config: resourcesConfig,
+			},
+		},
+	})
+}
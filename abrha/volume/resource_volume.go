@@ -5,6 +5,7 @@ import (
 	"fmt"
 	"log"
 	"strings"
+	"time"
 
 	goApiAbrha "github.com/abrhacom/go-api-abrha"
 	"github.com/abrhacom/terraform-provider-abrha/abrha/config"
@@ -110,6 +111,10 @@ func ResourceAbrhaVolume() *schema.Resource {
 			"tags": tag.TagsSchema(),
 		},
 
+		Timeouts: &schema.ResourceTimeout{
+			Update: schema.DefaultTimeout(60 * time.Minute),
+		},
+
 		CustomizeDiff: func(ctx context.Context, diff *schema.ResourceDiff, v interface{}) error {
 
 			// if the new size of the volume is smaller than the old one return an error since
@@ -180,7 +185,7 @@ func resourceAbrhaVolumeUpdate(ctx context.Context, d *schema.ResourceData, meta
 		}
 
 		log.Printf("[DEBUG] Volume resize action id: %d", action.ID)
-		if err = util.WaitForAction(client, action); err != nil {
+		if err = util.WaitForAction(ctx, client, action, d.Timeout(schema.TimeoutUpdate)); err != nil {
 			return diag.Errorf(
 				"Error waiting for resize volume (%s) to finish: %s", id, err)
 		}
@@ -36,6 +36,11 @@ func ResourceAbrhaVolumeAttachment() *schema.Resource {
 				ValidateFunc: validation.NoZeroValues,
 			},
 		},
+
+		Timeouts: &schema.ResourceTimeout{
+			Create: schema.DefaultTimeout(5 * time.Minute),
+			Delete: schema.DefaultTimeout(5 * time.Minute),
+		},
 	}
 }
 
@@ -45,7 +50,7 @@ func resourceAbrhaVolumeAttachmentCreate(ctx context.Context, d *schema.Resource
 	vmId := d.Get("vm_id").(string)
 	volumeId := d.Get("volume_id").(string)
 
-	volume, _, err := client.Storage.GetVolume(context.Background(), volumeId)
+	volume, _, err := client.Storage.GetVolume(ctx, volumeId)
 	if err != nil {
 		return diag.Errorf("Error retrieving volume: %s", err)
 	}
@@ -53,10 +58,10 @@ func resourceAbrhaVolumeAttachmentCreate(ctx context.Context, d *schema.Resource
 	if len(volume.VmIDs) == 0 || volume.VmIDs[0] != vmId {
 
 		// Only one volume can be attached at one time to a single vm.
-		err := retry.RetryContext(ctx, 5*time.Minute, func() *retry.RetryError {
+		err := retry.RetryContext(ctx, d.Timeout(schema.TimeoutCreate), func() *retry.RetryError {
 
 			log.Printf("[DEBUG] Attaching Volume (%s) to Vm (%s)", volumeId, vmId)
-			action, _, err := client.StorageActions.Attach(context.Background(), volumeId, vmId)
+			action, _, err := client.StorageActions.Attach(ctx, volumeId, vmId)
 			if err != nil {
 				if util.IsAbrhaError(err, 422, "Vm already has a pending event.") {
 					log.Printf("[DEBUG] Received %s, retrying attaching volume to vm", err)
@@ -68,7 +73,7 @@ func resourceAbrhaVolumeAttachmentCreate(ctx context.Context, d *schema.Resource
 			}
 
 			log.Printf("[DEBUG] Volume attach action id: %d", action.ID)
-			if err = util.WaitForAction(client, action); err != nil {
+			if err = util.WaitForAction(ctx, client, action, d.Timeout(schema.TimeoutCreate)); err != nil {
 				return retry.NonRetryableError(
 					fmt.Errorf("[DEBUG] Error waiting for attach volume (%s) to Vm (%s) to finish: %s", volumeId, vmId, err))
 			}
@@ -92,7 +97,7 @@ func resourceAbrhaVolumeAttachmentRead(ctx context.Context, d *schema.ResourceDa
 	vmId := d.Get("vm_id")
 	volumeId := d.Get("volume_id").(string)
 
-	volume, resp, err := client.Storage.GetVolume(context.Background(), volumeId)
+	volume, resp, err := client.Storage.GetVolume(ctx, volumeId)
 	if err != nil {
 		// If the volume is already destroyed, mark as
 		// successfully removed
@@ -119,10 +124,10 @@ func resourceAbrhaVolumeAttachmentDelete(ctx context.Context, d *schema.Resource
 	volumeId := d.Get("volume_id").(string)
 
 	// Only one volume can be detached at one time to a single vm.
-	err := retry.RetryContext(ctx, 5*time.Minute, func() *retry.RetryError {
+	err := retry.RetryContext(ctx, d.Timeout(schema.TimeoutDelete), func() *retry.RetryError {
 
 		log.Printf("[DEBUG] Detaching Volume (%s) from Vm (%s)", volumeId, vmId)
-		action, _, err := client.StorageActions.DetachByVmID(context.Background(), volumeId, vmId)
+		action, _, err := client.StorageActions.DetachByVmID(ctx, volumeId, vmId)
 		if err != nil {
 			if util.IsAbrhaError(err, 422, "Vm already has a pending event.") {
 				log.Printf("[DEBUG] Received %s, retrying detaching volume from vm", err)
@@ -134,7 +139,7 @@ func resourceAbrhaVolumeAttachmentDelete(ctx context.Context, d *schema.Resource
 		}
 
 		log.Printf("[DEBUG] Volume detach action id: %d", action.ID)
-		if err = util.WaitForAction(client, action); err != nil {
+		if err = util.WaitForAction(ctx, client, action, d.Timeout(schema.TimeoutDelete)); err != nil {
 			return retry.NonRetryableError(
 				fmt.Errorf("error waiting for detach volume (%s) from Vm (%s) to finish: %s", volumeId, vmId, err))
 		}
@@ -5,6 +5,7 @@ import (
 	"fmt"
 	"log"
 	"strings"
+	"time"
 
 	goApiAbrha "github.com/abrhacom/go-api-abrha"
 	"github.com/abrhacom/terraform-provider-abrha/abrha/config"
@@ -48,7 +49,7 @@ func testSweepVolumes(region string) error {
 					return fmt.Errorf("Error resizing volume (%s): %s", v.ID, err)
 				}
 
-				if err = util.WaitForAction(client, action); err != nil {
+				if err = util.WaitForAction(context.Background(), client, action, 60*time.Minute); err != nil {
 					return fmt.Errorf(
 						"Error waiting for volume (%s): %s", v.ID, err)
 				}
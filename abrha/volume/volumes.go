@@ -0,0 +1,129 @@
+package volume
+
+import (
+	"context"
+	"fmt"
+	"time"
+
+	goApiAbrha "github.com/abrhacom/go-api-abrha"
+	"github.com/abrhacom/terraform-provider-abrha/abrha/config"
+	"github.com/abrhacom/terraform-provider-abrha/abrha/tag"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/schema"
+)
+
+func volumeSchema() map[string]*schema.Schema {
+	return map[string]*schema.Schema{
+		"id": {
+			Type:        schema.TypeString,
+			Description: "id of the volume",
+		},
+		"name": {
+			Type:        schema.TypeString,
+			Description: "name of the volume",
+		},
+		"urn": {
+			Type:        schema.TypeString,
+			Description: "the uniform resource name for the volume",
+		},
+		"region": {
+			Type:        schema.TypeString,
+			Description: "the region that the volume is provisioned in",
+		},
+		"description": {
+			Type:        schema.TypeString,
+			Description: "volume description",
+		},
+		"size": {
+			Type:        schema.TypeInt,
+			Description: "the size of the volume in gigabytes",
+		},
+		"filesystem_type": {
+			Type:        schema.TypeString,
+			Description: "the type of filesystem currently in-use on the volume",
+		},
+		"filesystem_label": {
+			Type:        schema.TypeString,
+			Description: "the label currently applied to the filesystem",
+		},
+		"vm_ids": {
+			Type:        schema.TypeSet,
+			Elem:        &schema.Schema{Type: schema.TypeString},
+			Description: "list of vm ids the volume is attached to",
+		},
+		"created_at": {
+			Type:        schema.TypeString,
+			Description: "the creation date of the volume",
+		},
+		"tags": tag.TagsDataSourceSchema(),
+	}
+}
+
+func getAbrhaVolumes(meta interface{}, extra map[string]interface{}) ([]interface{}, error) {
+	client := meta.(*config.CombinedConfig).GoApiAbrhaClient()
+
+	opts := &goApiAbrha.ListVolumeParams{
+		ListOptions: &goApiAbrha.ListOptions{
+			Page:    1,
+			PerPage: 200,
+		},
+	}
+
+	if v, ok := extra["region"].(string); ok {
+		opts.Region = v
+	}
+
+	var volumeList []interface{}
+
+	for {
+		volumes, resp, err := client.Storage.ListVolumes(context.Background(), opts)
+		if err != nil {
+			return nil, fmt.Errorf("Error retrieving volumes: %s", err)
+		}
+
+		for _, volume := range volumes {
+			volumeList = append(volumeList, volume)
+		}
+
+		if resp.Links == nil || resp.Links.IsLastPage() {
+			break
+		}
+
+		page, err := resp.Links.CurrentPage()
+		if err != nil {
+			return nil, fmt.Errorf("Error retrieving volumes: %s", err)
+		}
+
+		opts.ListOptions.Page = page + 1
+	}
+
+	return volumeList, nil
+}
+
+func flattenAbrhaVolume(rawVolume, meta interface{}, extra map[string]interface{}) (map[string]interface{}, error) {
+	volume := rawVolume.(goApiAbrha.Volume)
+
+	flattenedVolume := map[string]interface{}{
+		"id":               volume.ID,
+		"name":             volume.Name,
+		"urn":              volume.URN(),
+		"description":      volume.Description,
+		"size":             int(volume.SizeGigaBytes),
+		"filesystem_type":  volume.FilesystemType,
+		"filesystem_label": volume.FilesystemLabel,
+		"created_at":       volume.CreatedAt.UTC().Format(time.RFC3339),
+	}
+
+	if volume.Region != nil {
+		flattenedVolume["region"] = volume.Region.Slug
+	}
+
+	vmIDs := schema.NewSet(schema.HashString, []interface{}{})
+	for _, id := range volume.VmIDs {
+		vmIDs.Add(id)
+	}
+	flattenedVolume["vm_ids"] = vmIDs
+
+	flattenedVolume["tags"] = tag.FlattenTags(volume.Tags)
+
+	return flattenedVolume, nil
+}
@@ -0,0 +1,17 @@
+package vpc
+
+import (
+	"github.com/abrhacom/terraform-provider-abrha/internal/datalist"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/schema"
+)
+
+func DataSourceAbrhaVPCs() *schema.Resource {
+	dataListConfig := &datalist.ResourceConfig{
+		RecordSchema:        vpcSchema(),
+		ResultAttributeName: "vpcs",
+		GetRecords:          getAbrhaVPCs,
+		FlattenRecord:       flattenAbrhaVPC,
+	}
+
+	return datalist.NewResource(dataListConfig)
+}
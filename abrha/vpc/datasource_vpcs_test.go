@@ -0,0 +1,61 @@
+package vpc_test
+
+import (
+	"fmt"
+	"testing"
+
+	"github.com/abrhacom/terraform-provider-abrha/abrha/acceptance"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/resource"
+)
+
+func TestAccDataSourceAbrhaVPCs_Basic(t *testing.T) {
+	vpcName1 := acceptance.RandomTestName()
+	vpcName2 := acceptance.RandomTestName()
+
+	resourcesConfig := fmt.Sprintf(`
+resource "abrha_vpc" "foo" {
+  name        = "%s"
+  description = "first test VPC"
+  region      = "nyc3"
+}
+
+resource "abrha_vpc" "bar" {
+  name        = "%s"
+  description = "second test VPC"
+  region      = "nyc3"
+}
+`, vpcName1, vpcName2)
+
+	datasourceConfig := fmt.Sprintf(`
+data "abrha_vpcs" "result" {
+  filter {
+    key    = "name"
+    values = ["%s"]
+  }
+}
+`, vpcName1)
+
+	resource.ParallelTest(t, resource.TestCase{
+		PreCheck:          func() { acceptance.TestAccPreCheck(t) },
+		ProviderFactories: acceptance.TestAccProviderFactories,
+		Steps: []resource.TestStep{
+			{
+				Config: resourcesConfig,
+			},
+			{
+				Config: resourcesConfig + datasourceConfig,
+				Check: resource.ComposeTestCheckFunc(
+					resource.TestCheckResourceAttr("data.abrha_vpcs.result", "vpcs.#", "1"),
+					resource.TestCheckResourceAttr("data.abrha_vpcs.result", "vpcs.0.name", vpcName1),
+					resource.TestCheckResourceAttr("data.abrha_vpcs.result", "vpcs.0.description", "first test VPC"),
+					resource.TestCheckResourceAttr("data.abrha_vpcs.result", "vpcs.0.region", "nyc3"),
+					resource.TestCheckResourceAttrPair("data.abrha_vpcs.result", "vpcs.0.id", "abrha_vpc.foo", "id"),
+					resource.TestCheckResourceAttrSet("data.abrha_vpcs.result", "vpcs.0.ip_range"),
+				),
+			},
+			{
+				Config: resourcesConfig,
+			},
+		},
+	})
+}
@@ -0,0 +1,77 @@
+package vpc
+
+import (
+	goApiAbrha "github.com/abrhacom/go-api-abrha"
+	"github.com/abrhacom/terraform-provider-abrha/abrha/config"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/schema"
+)
+
+func vpcSchema() map[string]*schema.Schema {
+	return map[string]*schema.Schema{
+		"id": {
+			Type:        schema.TypeString,
+			Description: "id of the VPC",
+		},
+		"name": {
+			Type:        schema.TypeString,
+			Description: "name of the VPC",
+		},
+		"urn": {
+			Type:        schema.TypeString,
+			Description: "the uniform resource name for the VPC",
+		},
+		"region": {
+			Type:        schema.TypeString,
+			Description: "the region that the VPC is located in",
+		},
+		"description": {
+			Type:        schema.TypeString,
+			Description: "a description of the VPC",
+		},
+		"ip_range": {
+			Type:        schema.TypeString,
+			Description: "the range of IP addresses for the VPC in CIDR notation",
+		},
+		"default": {
+			Type:        schema.TypeBool,
+			Description: "whether the VPC is the default one for its region",
+		},
+		"created_at": {
+			Type:        schema.TypeString,
+			Description: "the creation date of the VPC",
+		},
+	}
+}
+
+func getAbrhaVPCs(meta interface{}, extra map[string]interface{}) ([]interface{}, error) {
+	client := meta.(*config.CombinedConfig).GoApiAbrhaClient()
+
+	vpcs, err := listVPCs(client)
+	if err != nil {
+		return nil, err
+	}
+
+	vpcList := make([]interface{}, 0, len(vpcs))
+	for _, vpc := range vpcs {
+		vpcList = append(vpcList, vpc)
+	}
+
+	return vpcList, nil
+}
+
+func flattenAbrhaVPC(rawVPC, meta interface{}, extra map[string]interface{}) (map[string]interface{}, error) {
+	vpc := rawVPC.(*goApiAbrha.VPC)
+
+	flattenedVPC := map[string]interface{}{
+		"id":          vpc.ID,
+		"name":        vpc.Name,
+		"urn":         vpc.URN,
+		"region":      vpc.RegionSlug,
+		"description": vpc.Description,
+		"ip_range":    vpc.IPRange,
+		"default":     vpc.Default,
+		"created_at":  vpc.CreatedAt,
+	}
+
+	return flattenedVPC, nil
+}